@@ -0,0 +1,55 @@
+package calculators
+
+import (
+	"context"
+
+	"osrs-xp-kits/internal/calculators/probability"
+)
+
+// Metadata describes a calculator for listings and UIs
+type Metadata struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Category    string   `json:"category"` // "minigame" or "skilling"
+	Skills      []string `json:"skills"`
+}
+
+// Options holds request-wide settings that are not part of a calculator's own input
+type Options struct {
+	// Prices maps item names to live GE prices. A nil map means static prices are used.
+	Prices map[string]int
+	// Simulations is the number of Monte Carlo trials to run for loot distributions. Zero disables it.
+	Simulations int
+	// Seed drives every random simulation so a request can be reproduced exactly
+	Seed int64
+	// Context is the request context. Simulations stop when it is cancelled.
+	Context context.Context
+}
+
+// RequestContext returns the request context, or context.Background when none was set
+func (o Options) RequestContext() context.Context {
+	if o.Context == nil {
+		return context.Background()
+	}
+	return o.Context
+}
+
+// Calculator is implemented by every technique package.
+// I is the typed request input and R the result returned to the client.
+type Calculator[I, R any] interface {
+	// Metadata returns the calculator's ID, display name and trained skills
+	Metadata() Metadata
+	// Validate checks the input before Calculate is called
+	Validate(input I) error
+	// Calculate runs the calculation for a validated input
+	Calculate(input I, opts Options) (R, error)
+	// ProTips explains how the calculation works
+	ProTips() map[string]any
+}
+
+// UniqueOddsReporter is implemented by results that track rare drops such as pets.
+// The probability endpoint uses it to report the odds of a calculator's uniques.
+type UniqueOddsReporter interface {
+	UniqueDropOdds() []probability.DropOdds
+}
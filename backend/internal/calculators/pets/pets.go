@@ -0,0 +1,102 @@
+// Package pets models skilling pet odds. The chance per roll is 1/(base rate - 25·level)
+// and is 15 times higher once the skill reaches 200M XP.
+package pets
+
+import (
+	"fmt"
+	"math"
+
+	"osrs-xp-kits/internal/calculators/progression"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+const (
+	// LevelModifier is how much each level lowers the base rate
+	LevelModifier = 25
+	// MaxXPMultiplier is the boost to the pet chance at 200M XP
+	MaxXPMultiplier = 15
+)
+
+// Pet is a skilling pet and the base rate it is rolled at
+type Pet struct {
+	Name     string
+	BaseRate int
+}
+
+// Odds is the chance of getting a pet over a grind
+type Odds struct {
+	Name     string  `json:"name"`
+	BaseRate int     `json:"base_rate"`
+	Rolls    float64 `json:"rolls"`
+	// StartChance and EndChance are the chances per roll at the first and last level of the grind
+	StartChance float64 `json:"start_chance"`
+	EndChance   float64 `json:"end_chance"`
+	// EffectiveRate is the flat chance per roll that gives the same overall odds
+	EffectiveRate float64 `json:"effective_rate"`
+	// Chance is the chance of getting the pet at least once, from 0 to 1
+	Chance float64 `json:"chance"`
+}
+
+// Validate checks that the base rate stays above zero at level 99
+func (p Pet) Validate() error {
+	if p.BaseRate <= LevelModifier*xp.MaxLevel {
+		return fmt.Errorf("%s: base rate must be above %d, got %d", p.Name, LevelModifier*xp.MaxLevel, p.BaseRate)
+	}
+	return nil
+}
+
+// Chance returns the chance of the pet per roll for a player with the given XP.
+// Levels above 99 give no further improvement.
+func (p Pet) Chance(currentXP int) float64 {
+	level := xp.LevelForXP(currentXP)
+	chance := 1 / float64(p.BaseRate-LevelModifier*level)
+	if currentXP >= xp.MaxXP {
+		chance *= MaxXPMultiplier
+	}
+	return math.Min(chance, 1)
+}
+
+// AtXP returns the odds over rolls made without gaining a level, as when the XP is already capped
+func (p Pet) AtXP(currentXP int, rolls float64) (Odds, error) {
+	if err := p.Validate(); err != nil {
+		return Odds{}, err
+	}
+
+	chance := p.Chance(currentXP)
+	return Odds{
+		Name:          p.Name,
+		BaseRate:      p.BaseRate,
+		Rolls:         rolls,
+		StartChance:   chance,
+		EndChance:     chance,
+		EffectiveRate: chance,
+		Chance:        -math.Expm1(rolls * math.Log1p(-chance)),
+	}, nil
+}
+
+// OverProgression returns the odds over every level of a grind starting at startXP.
+// Each action of the progression gives rollsPerAction pet rolls at that level's chance.
+func (p Pet) OverProgression(startXP int, prog progression.Progression, rollsPerAction float64) (Odds, error) {
+	odds, err := p.AtXP(startXP, 0)
+	if err != nil || len(prog.Segments) == 0 {
+		return odds, err
+	}
+
+	// Sum log(1 - chance) over every roll so the odds of no pet multiply across levels
+	logNone := 0.0
+	for _, segment := range prog.Segments {
+		rolls := segment.Actions * rollsPerAction
+		chance := p.Chance(segment.StartXP)
+
+		odds.Rolls += rolls
+		odds.EndChance = chance
+		logNone += rolls * math.Log1p(-chance)
+	}
+
+	odds.Chance = -math.Expm1(logNone)
+	if odds.Rolls > 0 {
+		odds.EffectiveRate = -math.Expm1(logNone / odds.Rolls)
+	}
+
+	return odds, nil
+}
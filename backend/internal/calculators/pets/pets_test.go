@@ -0,0 +1,88 @@
+package pets
+
+import (
+	"math"
+	"testing"
+
+	"osrs-xp-kits/internal/calculators/progression"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+var phoenix = Pet{Name: "Phoenix", BaseRate: 5000}
+
+func TestChance(t *testing.T) {
+	tests := []struct {
+		name      string
+		currentXP int
+		want      float64
+	}{
+		{"level 50", xp.ForLevel(50), 1.0 / 3750},
+		{"level 99", xp.ForLevel(99), 1.0 / 2525},
+		{"virtual level 110", xp.ForLevel(110), 1.0 / 2525},
+		{"200M XP", xp.MaxXP, 15.0 / 2525},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := phoenix.Chance(tt.currentXP); math.Abs(got-tt.want) > 1e-15 {
+				t.Errorf("Chance(%d) = %g, want %g", tt.currentXP, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOverProgression(t *testing.T) {
+	rate := func(level int) progression.Rate {
+		return progression.Rate{XPPerAction: 20000, ActionsPerHour: 4, SuccessChance: 1}
+	}
+	prog, err := progression.WalkLevels(70, 99, rate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	odds, err := phoenix.OverProgression(xp.ForLevel(70), prog, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Multiplying the chance of no pet at every level must match the combined odds
+	none := 1.0
+	for _, segment := range prog.Segments {
+		none *= math.Pow(1-1/float64(5000-25*segment.Level), segment.Actions)
+	}
+	if math.Abs(odds.Chance-(1-none)) > 1e-12 {
+		t.Errorf("Expected chance %g, got %g", 1-none, odds.Chance)
+	}
+
+	if math.Abs(odds.Rolls-prog.TotalActions) > 1e-9 {
+		t.Errorf("Expected %.2f rolls, got %.2f", prog.TotalActions, odds.Rolls)
+	}
+	if odds.StartChance != 1.0/3250 || odds.EndChance != 1.0/2550 {
+		t.Errorf("Unexpected start and end chances: %g, %g", odds.StartChance, odds.EndChance)
+	}
+	if odds.EffectiveRate <= odds.StartChance || odds.EffectiveRate >= odds.EndChance {
+		t.Errorf("Effective rate %g should sit between the start and end chances", odds.EffectiveRate)
+	}
+
+	// A flat rate at the starting level underestimates the odds
+	flat, _ := phoenix.AtXP(xp.ForLevel(70), odds.Rolls)
+	if flat.Chance >= odds.Chance {
+		t.Errorf("Level scaling should improve the odds over the grind: flat %g, scaled %g", flat.Chance, odds.Chance)
+	}
+}
+
+func TestOverEmptyProgression(t *testing.T) {
+	odds, err := phoenix.OverProgression(xp.ForLevel(80), progression.Progression{}, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if odds.Chance != 0 || odds.EffectiveRate != 1.0/3000 {
+		t.Errorf("Expected no chance and the level 80 rate, got %+v", odds)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if _, err := (Pet{Name: "Bad", BaseRate: 2000}).AtXP(0, 10); err == nil {
+		t.Errorf("Expected error for a base rate that reaches zero by level 99")
+	}
+}
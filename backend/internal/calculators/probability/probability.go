@@ -0,0 +1,179 @@
+// Package probability answers drop-rate questions: the chance of at least k drops in n attempts,
+// the attempts needed to reach a confidence, and how unusual a dry streak is.
+package probability
+
+import (
+	"fmt"
+	"math"
+)
+
+// Limits on the attempts and k accepted by NewDropOdds. AtLeast sums k binomial terms,
+// so k bounds the work of a single request.
+const (
+	MaxAttempts = 1_000_000_000
+	MaxK        = 10_000
+)
+
+// DefaultConfidences are the confidence levels reported for every unique
+var DefaultConfidences = []float64{0.5, 0.9, 0.99}
+
+// ConfidenceAttempts is the number of attempts needed to get a drop with the given confidence
+type ConfidenceAttempts struct {
+	Confidence float64 `json:"confidence"`
+	Attempts   int     `json:"attempts"`
+}
+
+// DropOdds summarises the odds of one drop over a number of attempts
+type DropOdds struct {
+	Name          string  `json:"name,omitempty"`
+	Rate          float64 `json:"rate"`
+	OneIn         float64 `json:"one_in"`
+	Attempts      int     `json:"attempts"`
+	ExpectedDrops float64 `json:"expected_drops"`
+	K             int     `json:"k"`
+	// ChanceAtLeastK is the chance of getting the drop at least K times
+	ChanceAtLeastK float64 `json:"chance_at_least_k"`
+	// DryStreakPercentile is the percentage of players who would have at least one drop by now.
+	// Someone still without it after Attempts is drier than this share of players.
+	DryStreakPercentile   float64              `json:"dry_streak_percentile"`
+	AttemptsForConfidence []ConfidenceAttempts `json:"attempts_for_confidence"`
+}
+
+// ValidateRate checks that a drop rate is a probability above zero
+func ValidateRate(rate float64) error {
+	if math.IsNaN(rate) || rate <= 0 || rate > 1 {
+		return fmt.Errorf("rate must be greater than 0 and at most 1, got %g", rate)
+	}
+	return nil
+}
+
+// ValidateConfidence checks that a confidence is strictly between 0 and 1
+func ValidateConfidence(confidence float64) error {
+	if math.IsNaN(confidence) || confidence <= 0 || confidence >= 1 {
+		return fmt.Errorf("confidence must be between 0 and 1, got %g", confidence)
+	}
+	return nil
+}
+
+// AtLeastOne returns the chance of at least one drop in attempts, 1-(1-p)^n.
+// Attempts may be fractional when they come from an average.
+func AtLeastOne(rate float64, attempts float64) float64 {
+	if attempts <= 0 || rate <= 0 {
+		return 0
+	}
+	if rate >= 1 {
+		return 1
+	}
+	// -expm1(n·log1p(-p)) keeps precision for tiny rates
+	return -math.Expm1(attempts * math.Log1p(-rate))
+}
+
+// AtLeast returns the binomial chance of at least k drops in attempts.
+// It sums k terms, so callers taking k from a request should check it against MaxK.
+func AtLeast(rate float64, attempts, k int) float64 {
+	switch {
+	case k <= 0:
+		return 1
+	case k > attempts || rate <= 0:
+		return 0
+	case rate >= 1:
+		return 1
+	case k == 1:
+		return AtLeastOne(rate, float64(attempts))
+	}
+
+	// 1 - P(X < k), summing the binomial terms in log space so large attempts do not overflow
+	logP, logQ := math.Log(rate), math.Log1p(-rate)
+	below := 0.0
+	for i := 0; i < k; i++ {
+		below += math.Exp(logChoose(attempts, i) + float64(i)*logP + float64(attempts-i)*logQ)
+	}
+
+	return math.Max(0, math.Min(1, 1-below))
+}
+
+// AttemptsForConfidence returns the attempts needed for at least one drop with the given confidence
+func AttemptsForConfidence(rate, confidence float64) (int, error) {
+	if err := ValidateRate(rate); err != nil {
+		return 0, err
+	}
+	if err := ValidateConfidence(confidence); err != nil {
+		return 0, err
+	}
+	if rate == 1 {
+		return 1, nil
+	}
+
+	return int(math.Ceil(math.Log1p(-confidence) / math.Log1p(-rate))), nil
+}
+
+// DryStreakPercentile returns the percentage of players who would have had at least one drop
+// within attempts. Going dry for that long is rarer than this share of players.
+func DryStreakPercentile(rate float64, attempts int) float64 {
+	return AtLeastOne(rate, float64(attempts)) * 100
+}
+
+// NewDropOdds computes the odds of a drop over a number of attempts.
+// Confidences default to DefaultConfidences when none are given.
+func NewDropOdds(name string, rate float64, attempts, k int, confidences ...float64) (DropOdds, error) {
+	if err := ValidateRate(rate); err != nil {
+		return DropOdds{}, err
+	}
+	if attempts < 0 || attempts > MaxAttempts {
+		return DropOdds{}, fmt.Errorf("attempts must be between 0 and %d, got %d", MaxAttempts, attempts)
+	}
+	if k < 1 || k > MaxK {
+		return DropOdds{}, fmt.Errorf("k must be between 1 and %d, got %d", MaxK, k)
+	}
+	if len(confidences) == 0 {
+		confidences = DefaultConfidences
+	}
+
+	forConfidence := make([]ConfidenceAttempts, 0, len(confidences))
+	for _, confidence := range confidences {
+		needed, err := AttemptsForConfidence(rate, confidence)
+		if err != nil {
+			return DropOdds{}, err
+		}
+		forConfidence = append(forConfidence, ConfidenceAttempts{Confidence: confidence, Attempts: needed})
+	}
+
+	return DropOdds{
+		Name:                  name,
+		Rate:                  rate,
+		OneIn:                 1 / rate,
+		Attempts:              attempts,
+		ExpectedDrops:         rate * float64(attempts),
+		K:                     k,
+		ChanceAtLeastK:        AtLeast(rate, attempts, k),
+		DryStreakPercentile:   DryStreakPercentile(rate, attempts),
+		AttemptsForConfidence: forConfidence,
+	}, nil
+}
+
+// Unique is a named drop with its rate per attempt
+type Unique struct {
+	Name string
+	Rate float64
+}
+
+// UniqueOdds returns the odds of at least one of each unique over the same number of attempts
+func UniqueOdds(uniques []Unique, attempts int) ([]DropOdds, error) {
+	odds := make([]DropOdds, 0, len(uniques))
+	for _, unique := range uniques {
+		drop, err := NewDropOdds(unique.Name, unique.Rate, attempts, 1)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", unique.Name, err)
+		}
+		odds = append(odds, drop)
+	}
+	return odds, nil
+}
+
+// logChoose returns log(n choose k)
+func logChoose(n, k int) float64 {
+	a, _ := math.Lgamma(float64(n + 1))
+	b, _ := math.Lgamma(float64(k + 1))
+	c, _ := math.Lgamma(float64(n - k + 1))
+	return a - b - c
+}
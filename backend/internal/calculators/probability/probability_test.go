@@ -0,0 +1,114 @@
+package probability
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAtLeastOne(t *testing.T) {
+	tests := []struct {
+		name     string
+		rate     float64
+		attempts float64
+		want     float64
+	}{
+		{"one attempt", 0.25, 1, 0.25},
+		{"two attempts", 0.5, 2, 0.75},
+		{"pet rate", 1.0 / 5000, 5000, 1 - math.Pow(1-1.0/5000, 5000)},
+		{"no attempts", 0.5, 0, 0},
+		{"guaranteed", 1, 3, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AtLeastOne(tt.rate, tt.attempts)
+			if math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("AtLeastOne(%g, %g) = %g, want %g", tt.rate, tt.attempts, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAtLeast(t *testing.T) {
+	// P(X >= 2) for n=10, p=0.1 is 1 - 0.9^10 - 10·0.1·0.9^9
+	want := 1 - math.Pow(0.9, 10) - 10*0.1*math.Pow(0.9, 9)
+	if got := AtLeast(0.1, 10, 2); math.Abs(got-want) > 1e-12 {
+		t.Errorf("AtLeast(0.1, 10, 2) = %g, want %g", got, want)
+	}
+
+	if got := AtLeast(0.1, 10, 1); math.Abs(got-AtLeastOne(0.1, 10)) > 1e-12 {
+		t.Errorf("AtLeast with k=1 should match AtLeastOne, got %g", got)
+	}
+	if got := AtLeast(0.5, 3, 4); got != 0 {
+		t.Errorf("More drops than attempts should be impossible, got %g", got)
+	}
+
+	// Large attempt counts must not overflow
+	got := AtLeast(1.0/5000, 100000, 20)
+	if math.IsNaN(got) || got < 0.4 || got > 0.6 {
+		t.Errorf("Expected about half a chance of 20 drops at the mean, got %g", got)
+	}
+}
+
+func TestAttemptsForConfidence(t *testing.T) {
+	tests := []struct {
+		rate       float64
+		confidence float64
+		want       int
+	}{
+		{1.0 / 5000, 0.5, 3466},
+		{1.0 / 5000, 0.9, 11512},
+		{0.5, 0.75, 2},
+		{1, 0.99, 1},
+	}
+
+	for _, tt := range tests {
+		got, err := AttemptsForConfidence(tt.rate, tt.confidence)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("AttemptsForConfidence(%g, %g) = %d, want %d", tt.rate, tt.confidence, got, tt.want)
+		}
+	}
+
+	if _, err := AttemptsForConfidence(0, 0.5); err == nil {
+		t.Errorf("Expected error for a zero rate")
+	}
+	if _, err := AttemptsForConfidence(0.1, 1); err == nil {
+		t.Errorf("Expected error for a confidence of 1")
+	}
+}
+
+func TestNewDropOdds(t *testing.T) {
+	odds, err := NewDropOdds("Phoenix", 1.0/5000, 5000, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if odds.OneIn != 5000 || odds.ExpectedDrops != 1 {
+		t.Errorf("Unexpected summary: %+v", odds)
+	}
+	if math.Abs(odds.DryStreakPercentile-63.21) > 0.01 {
+		t.Errorf("Expected about 63.21%% of players to have the drop at the rate, got %.4f", odds.DryStreakPercentile)
+	}
+	if len(odds.AttemptsForConfidence) != len(DefaultConfidences) {
+		t.Errorf("Expected %d confidence levels, got %d", len(DefaultConfidences), len(odds.AttemptsForConfidence))
+	}
+
+	if _, err := NewDropOdds("Bad", 2, 10, 1); err == nil {
+		t.Errorf("Expected error for a rate above 1")
+	}
+	if _, err := NewDropOdds("Bad", 0.1, -1, 1); err == nil {
+		t.Errorf("Expected error for negative attempts")
+	}
+	if _, err := NewDropOdds("Bad", 0.1, 10, 0); err == nil {
+		t.Errorf("Expected error for k below 1")
+	}
+	if _, err := NewDropOdds("Bad", 0.5, MaxAttempts+1, 1); err == nil {
+		t.Errorf("Expected error for attempts above MaxAttempts")
+	}
+	if _, err := NewDropOdds("Bad", 0.5, MaxAttempts, MaxK+1); err == nil {
+		t.Errorf("Expected error for k above MaxK")
+	}
+}
@@ -0,0 +1,126 @@
+// Package progression walks a grind level by level so that rates which change with level
+// are applied to the XP actually earned at that level, instead of to an average level.
+package progression
+
+import (
+	"fmt"
+
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+// Rate describes how a training method performs at one level
+type Rate struct {
+	// XPPerAction is the XP for one successful action (a pickpocket, a round, a game)
+	XPPerAction float64
+	// ActionsPerHour is the number of attempts made per hour
+	ActionsPerHour float64
+	// SuccessChance is the chance an attempt gives XP, 1 for methods that never fail
+	SuccessChance float64
+}
+
+// RateFunc returns the rate at a real level (1-99)
+type RateFunc func(level int) Rate
+
+// Segment is the progress made while at one level
+type Segment struct {
+	Level           int     `json:"level"`
+	StartXP         int     `json:"start_xp"`
+	EndXP           int     `json:"end_xp"`
+	XP              int     `json:"xp"`
+	SuccessChance   float64 `json:"success_chance"`
+	XPPerHour       float64 `json:"xp_per_hour"`
+	Actions         float64 `json:"actions"`
+	Hours           float64 `json:"hours"`
+	CumulativeHours float64 `json:"cumulative_hours"`
+}
+
+// Progression is the full level-by-level breakdown of a grind
+type Progression struct {
+	Segments         []Segment `json:"segments"`
+	TotalXP          int       `json:"total_xp"`
+	TotalActions     float64   `json:"total_actions"`
+	TotalHours       float64   `json:"total_hours"`
+	AverageXPPerHour float64   `json:"average_xp_per_hour"`
+}
+
+// XPPerHour returns the effective XP per hour of the rate
+func (r Rate) XPPerHour() float64 {
+	return r.XPPerAction * r.SuccessChance * r.ActionsPerHour
+}
+
+// Walk splits the XP between startXP and targetXP into one segment per level and applies
+// that level's rate to each. Virtual levels above 99 use the level 99 rate.
+// When no XP is needed the average XP per hour is the rate at the starting level.
+func Walk(startXP, targetXP int, rate RateFunc) (Progression, error) {
+	if err := xp.ValidateXP(startXP); err != nil {
+		return Progression{}, fmt.Errorf("invalid start XP: %w", err)
+	}
+	if err := xp.ValidateXP(targetXP); err != nil {
+		return Progression{}, fmt.Errorf("invalid target XP: %w", err)
+	}
+
+	progression := Progression{Segments: []Segment{}}
+
+	if targetXP <= startXP {
+		progression.AverageXPPerHour = rate(xp.LevelForXP(startXP)).XPPerHour()
+		return progression, nil
+	}
+
+	for currentXP := startXP; currentXP < targetXP; {
+		level := xp.VirtualLevelForXP(currentXP)
+		endXP := min(nextLevelXP(level), targetXP)
+
+		r := rate(min(level, xp.MaxLevel))
+		xpPerAction := r.XPPerAction * r.SuccessChance
+		if xpPerAction <= 0 || r.ActionsPerHour <= 0 {
+			return Progression{}, fmt.Errorf("no XP can be gained at level %d", level)
+		}
+
+		gained := endXP - currentXP
+		actions := float64(gained) / xpPerAction
+		hours := actions / r.ActionsPerHour
+
+		progression.TotalXP += gained
+		progression.TotalActions += actions
+		progression.TotalHours += hours
+
+		progression.Segments = append(progression.Segments, Segment{
+			Level:           level,
+			StartXP:         currentXP,
+			EndXP:           endXP,
+			XP:              gained,
+			SuccessChance:   r.SuccessChance,
+			XPPerHour:       r.XPPerHour(),
+			Actions:         actions,
+			Hours:           hours,
+			CumulativeHours: progression.TotalHours,
+		})
+
+		currentXP = endXP
+	}
+
+	progression.AverageXPPerHour = float64(progression.TotalXP) / progression.TotalHours
+
+	return progression, nil
+}
+
+// WalkLevels is Walk between the start of two levels
+func WalkLevels(currentLevel, targetLevel int, rate RateFunc) (Progression, error) {
+	return Walk(xp.ForLevel(currentLevel), xp.ForLevel(targetLevel), rate)
+}
+
+// AverageXPPerAction returns the XP per attempt averaged over the whole grind
+func (p Progression) AverageXPPerAction() float64 {
+	if p.TotalActions == 0 {
+		return 0
+	}
+	return float64(p.TotalXP) / p.TotalActions
+}
+
+// nextLevelXP returns the XP at which level ends. The last virtual level ends at the XP cap.
+func nextLevelXP(level int) int {
+	if level >= xp.MaxVirtualLevel {
+		return xp.MaxXP
+	}
+	return xp.ForLevel(level + 1)
+}
@@ -0,0 +1,139 @@
+package progression
+
+import (
+	"math"
+	"testing"
+
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+// flatRate gives 10 XP per action and 100 actions per hour at every level
+func flatRate(level int) Rate {
+	return Rate{XPPerAction: 10, ActionsPerHour: 100, SuccessChance: 1}
+}
+
+func TestWalkSegments(t *testing.T) {
+	p, err := WalkLevels(50, 60, flatRate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(p.Segments) != 10 {
+		t.Fatalf("Expected 10 segments, got %d", len(p.Segments))
+	}
+
+	xpNeeded := xp.ForLevel(60) - xp.ForLevel(50)
+	if p.TotalXP != xpNeeded {
+		t.Errorf("Expected total XP %d, got %d", xpNeeded, p.TotalXP)
+	}
+
+	for i, segment := range p.Segments {
+		if segment.Level != 50+i {
+			t.Errorf("Segment %d: expected level %d, got %d", i, 50+i, segment.Level)
+		}
+		if segment.XP != segment.EndXP-segment.StartXP {
+			t.Errorf("Segment %d: XP %d does not match its range", i, segment.XP)
+		}
+		if i > 0 && segment.StartXP != p.Segments[i-1].EndXP {
+			t.Errorf("Segment %d does not start where the previous one ended", i)
+		}
+	}
+
+	last := p.Segments[len(p.Segments)-1]
+	if math.Abs(last.CumulativeHours-p.TotalHours) > 1e-9 {
+		t.Errorf("Cumulative hours %.4f should equal total hours %.4f", last.CumulativeHours, p.TotalHours)
+	}
+	if math.Abs(p.TotalHours-float64(xpNeeded)/1000) > 1e-9 {
+		t.Errorf("Expected %.4f hours at a flat 1000 XP/h, got %.4f", float64(xpNeeded)/1000, p.TotalHours)
+	}
+	if math.Abs(p.AverageXPPerHour-1000) > 1e-9 {
+		t.Errorf("Expected average 1000 XP/h, got %.4f", p.AverageXPPerHour)
+	}
+}
+
+func TestWalkUsesEachLevelsRate(t *testing.T) {
+	// XP per hour equals the level, so the XP-weighted average sits well above the midpoint
+	rate := func(level int) Rate {
+		return Rate{XPPerAction: float64(level), ActionsPerHour: 1, SuccessChance: 1}
+	}
+
+	p, err := WalkLevels(50, 99, rate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if p.AverageXPPerHour <= 74.5 {
+		t.Errorf("Expected an XP-weighted rate above the average level, got %.2f", p.AverageXPPerHour)
+	}
+	if p.Segments[0].XPPerHour != 50 || p.Segments[len(p.Segments)-1].XPPerHour != 98 {
+		t.Errorf("Segments should use their own level's rate")
+	}
+}
+
+func TestWalkSuccessChance(t *testing.T) {
+	rate := func(level int) Rate {
+		return Rate{XPPerAction: 100, ActionsPerHour: 10, SuccessChance: 0.5}
+	}
+
+	p, err := Walk(0, 1000, rate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if math.Abs(p.TotalActions-20) > 1e-9 {
+		t.Errorf("Expected 20 attempts at 50%% success, got %.4f", p.TotalActions)
+	}
+	if math.Abs(p.TotalHours-2) > 1e-9 {
+		t.Errorf("Expected 2 hours, got %.4f", p.TotalHours)
+	}
+}
+
+func TestWalkVirtualLevels(t *testing.T) {
+	var seen []int
+	rate := func(level int) Rate {
+		seen = append(seen, level)
+		return flatRate(level)
+	}
+
+	p, err := Walk(xp.ForLevel(126), xp.MaxXP, rate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(p.Segments) != 1 || p.Segments[0].Level != 126 || p.Segments[0].EndXP != xp.MaxXP {
+		t.Errorf("Expected one level 126 segment up to the XP cap, got %+v", p.Segments)
+	}
+	for _, level := range seen {
+		if level > xp.MaxLevel {
+			t.Errorf("Rate should be called with real levels, got %d", level)
+		}
+	}
+}
+
+func TestWalkErrors(t *testing.T) {
+	if _, err := Walk(-1, 100, flatRate); err == nil {
+		t.Errorf("Expected error for negative start XP")
+	}
+	if _, err := Walk(0, xp.MaxXP+1, flatRate); err == nil {
+		t.Errorf("Expected error above the XP cap")
+	}
+
+	noXP := func(level int) Rate { return Rate{XPPerAction: 10, ActionsPerHour: 100} }
+	if _, err := Walk(0, 100, noXP); err == nil {
+		t.Errorf("Expected error when no XP can be gained")
+	}
+}
+
+func TestWalkNothingToGain(t *testing.T) {
+	p, err := WalkLevels(70, 70, flatRate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(p.Segments) != 0 || p.TotalHours != 0 {
+		t.Errorf("Expected an empty progression, got %+v", p)
+	}
+	if p.AverageXPPerHour != 1000 {
+		t.Errorf("Expected the starting rate, got %.2f", p.AverageXPPerHour)
+	}
+}
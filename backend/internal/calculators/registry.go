@@ -0,0 +1,114 @@
+package calculators
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ErrInvalidInput is returned when a request body cannot be decoded or fails validation
+var ErrInvalidInput = errors.New("invalid input")
+
+// Entry is a registered calculator with its input and result types erased,
+// so that calculators of different shapes can be served by one handler.
+type Entry interface {
+	Metadata() Metadata
+	ProTips() map[string]any
+	// Calculate decodes raw JSON into the calculator's input, validates it and runs the calculation
+	Calculate(raw []byte, opts Options) (any, error)
+}
+
+// entry adapts a typed Calculator to the Entry interface
+type entry[I, R any] struct {
+	calc Calculator[I, R]
+}
+
+func (e entry[I, R]) Metadata() Metadata {
+	return e.calc.Metadata()
+}
+
+func (e entry[I, R]) ProTips() map[string]any {
+	return e.calc.ProTips()
+}
+
+func (e entry[I, R]) Calculate(raw []byte, opts Options) (any, error) {
+	var input I
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &input); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+	}
+
+	if err := e.calc.Validate(input); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	return e.calc.Calculate(input, opts)
+}
+
+// Registry holds calculators keyed by their metadata ID
+type Registry struct {
+	mu      sync.RWMutex
+	entries map[string]Entry
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{
+		entries: make(map[string]Entry),
+	}
+}
+
+// Register adds a calculator to the registry.
+// It returns an error if the ID is empty or already registered.
+func Register[I, R any](r *Registry, c Calculator[I, R]) error {
+	id := c.Metadata().ID
+	if id == "" {
+		return fmt.Errorf("calculator ID cannot be empty")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.entries[id]; exists {
+		return fmt.Errorf("calculator %q is already registered", id)
+	}
+
+	r.entries[id] = entry[I, R]{calc: c}
+	return nil
+}
+
+// MustRegister is like Register but panics on error. It is intended for wiring at startup.
+func MustRegister[I, R any](r *Registry, c Calculator[I, R]) {
+	if err := Register(r, c); err != nil {
+		panic(err)
+	}
+}
+
+// Get returns the calculator registered under id
+func (r *Registry) Get(id string) (Entry, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	e, ok := r.entries[id]
+	return e, ok
+}
+
+// List returns the metadata of every registered calculator, sorted by ID
+func (r *Registry) List() []Metadata {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := make([]Metadata, 0, len(r.entries))
+	for _, e := range r.entries {
+		list = append(list, e.Metadata())
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID < list[j].ID
+	})
+
+	return list
+}
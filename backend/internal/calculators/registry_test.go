@@ -0,0 +1,110 @@
+package calculators
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type echoInput struct {
+	Value int `json:"value"`
+}
+
+type echoCalculator struct {
+	id string
+}
+
+func (c echoCalculator) Metadata() Metadata {
+	return Metadata{ID: c.id, Name: "Echo"}
+}
+
+func (c echoCalculator) Validate(input echoInput) error {
+	if input.Value < 0 {
+		return fmt.Errorf("value cannot be negative")
+	}
+	return nil
+}
+
+func (c echoCalculator) Calculate(input echoInput, opts Options) (int, error) {
+	return input.Value * 2, nil
+}
+
+func (c echoCalculator) ProTips() map[string]any {
+	return map[string]any{"tip": "echo"}
+}
+
+func TestRegistryRegisterAndGet(t *testing.T) {
+	r := NewRegistry()
+
+	if err := Register(r, echoCalculator{id: "echo"}); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+
+	if err := Register(r, echoCalculator{id: "echo"}); err == nil {
+		t.Error("Expected error registering duplicate ID")
+	}
+
+	if err := Register(r, echoCalculator{id: ""}); err == nil {
+		t.Error("Expected error registering empty ID")
+	}
+
+	entry, ok := r.Get("echo")
+	if !ok {
+		t.Fatal("Registered calculator not found")
+	}
+
+	result, err := entry.Calculate([]byte(`{"value": 21}`), Options{})
+	if err != nil {
+		t.Fatalf("Calculate failed: %v", err)
+	}
+	if result != 42 {
+		t.Errorf("Expected result 42, got %v", result)
+	}
+
+	if _, ok := r.Get("missing"); ok {
+		t.Error("Expected missing calculator to not be found")
+	}
+}
+
+func TestRegistryInvalidInput(t *testing.T) {
+	r := NewRegistry()
+	MustRegister(r, echoCalculator{id: "echo"})
+	entry, _ := r.Get("echo")
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"Malformed JSON", `{"value":`},
+		{"Wrong type", `{"value": "abc"}`},
+		{"Fails validation", `{"value": -1}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := entry.Calculate([]byte(tt.body), Options{})
+			if !errors.Is(err, ErrInvalidInput) {
+				t.Errorf("Expected ErrInvalidInput, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRegistryListSorted(t *testing.T) {
+	r := NewRegistry()
+	MustRegister(r, echoCalculator{id: "zeta"})
+	MustRegister(r, echoCalculator{id: "alpha"})
+	MustRegister(r, echoCalculator{id: "mid"})
+
+	list := r.List()
+	if len(list) != 3 {
+		t.Fatalf("Expected 3 calculators, got %d", len(list))
+	}
+
+	expected := []string{"alpha", "mid", "zeta"}
+	for i, id := range expected {
+		if list[i].ID != id {
+			t.Errorf("Position %d: expected %s, got %s", i, id, list[i].ID)
+		}
+	}
+}
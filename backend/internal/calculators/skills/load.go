@@ -0,0 +1,36 @@
+package skills
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+//go:embed json/*.json
+var skillFiles embed.FS
+
+// Load returns the embedded training data for a skill, e.g. "agility"
+func Load(skill string) (SkillData, error) {
+	data, err := skillFiles.ReadFile("json/" + skill + ".json")
+	if err != nil {
+		return SkillData{}, fmt.Errorf("skill data not found for: %s", skill)
+	}
+
+	var skillData SkillData
+	if err := json.Unmarshal(data, &skillData); err != nil {
+		return SkillData{}, fmt.Errorf("failed to parse skill data for %s: %w", skill, err)
+	}
+	return skillData, nil
+}
+
+// MethodsWithTag returns the training methods carrying the given tag, in file order
+func (s SkillData) MethodsWithTag(tag string) []TrainingMethod {
+	var methods []TrainingMethod
+	for _, method := range s.TrainingMethods {
+		if slices.Contains(method.Tags, tag) {
+			methods = append(methods, method)
+		}
+	}
+	return methods
+}
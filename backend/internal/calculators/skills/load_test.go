@@ -0,0 +1,71 @@
+package skills
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestLoadEverySkill(t *testing.T) {
+	entries, err := skillFiles.ReadDir("json")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()[:len(entry.Name())-len(".json")]
+		data, err := Load(name)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if data.SkillNameCanonical != name {
+			t.Errorf("%s: canonical name is %q", name, data.SkillNameCanonical)
+		}
+	}
+}
+
+func TestLoadUnknownSkill(t *testing.T) {
+	if _, err := Load("sailing"); err == nil {
+		t.Errorf("Expected error for unknown skill")
+	}
+}
+
+func TestMethodsWithTag(t *testing.T) {
+	agility, err := Load("agility")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	rooftops := agility.MethodsWithTag("rooftop")
+	if len(rooftops) == 0 {
+		t.Fatalf("Expected rooftop courses in the agility data")
+	}
+	for _, method := range rooftops {
+		if method.MarksPerHour == nil {
+			t.Errorf("%s: rooftop course without marks per hour", method.ID)
+		}
+	}
+}
+
+func TestAgilityMirrorsSkillAssets(t *testing.T) {
+	// The rooftop calculator reads the embedded JSON while the skill data endpoint serves the YAML asset
+	raw, err := os.ReadFile("../../../assets/data/skills/agility.yaml")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var asset SkillData
+	if err := yaml.Unmarshal(raw, &asset); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	embedded, err := Load("agility")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(embedded, asset) {
+		t.Errorf("json/agility.json does not match assets/data/skills/agility.yaml")
+	}
+}
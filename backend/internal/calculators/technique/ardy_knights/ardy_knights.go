@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"math"
 	"sort"
+
+	"osrs-xp-kits/internal/calculators/progression"
+	"osrs-xp-kits/internal/calculators/xp"
 )
 
 type ArdyKnightResult struct {
@@ -22,7 +25,10 @@ type ArdyKnightResult struct {
 	TargetTotalXP        int     `json:"target_total_xp"`
 	XPToTarget           int     `json:"xp_to_target"`
 	HoursToTarget        float64 `json:"hours_to_target"`
+	AttemptsToTarget     int     `json:"attempts_to_target"`
 	PickpocketsToTarget  int     `json:"pickpockets_to_target"`
+
+	Progression progression.Progression `json:"progression"`
 }
 
 func getArdyKnightBaseSuccessChance(level int) float64 {
@@ -83,6 +89,25 @@ func getArdyKnightBaseSuccessChance(level int) float64 {
 	return interpolatedChance
 }
 
+// successChanceAt returns the total pickpocket success chance at a level with the given boosts
+func successChanceAt(level int, hasArdyMed, hasThievingCape, hasShadowVeil bool) float64 {
+	totalSuccessChance := getArdyKnightBaseSuccessChance(level)
+
+	if hasArdyMed {
+		totalSuccessChance += ArdyHardBoost
+	}
+	if hasThievingCape {
+		totalSuccessChance += ThievingCapeBoost
+	}
+	if hasShadowVeil {
+		totalSuccessChance += ShadowVeilBoost
+	}
+	if level >= 99 && hasArdyMed && hasThievingCape && hasShadowVeil {
+		return math.Min(totalSuccessChance, 0.995)
+	}
+	return math.Min(totalSuccessChance, 1.0)
+}
+
 // CalculateArdyKnightStats calculates XP, GP, and other stats for pickpocketing Ardougne Knights.
 func CalculateArdyKnightStats(
 	currentThievingXP int,
@@ -97,12 +122,16 @@ func CalculateArdyKnightStats(
 ) (ArdyKnightResult, error) {
 
 	currentLevel := GetLevelForXP(currentThievingXP)
-	derivedTargetLevel := GetLevelForXP(targetThievingXP)
+	derivedTargetLevel := xp.VirtualLevelForXP(targetThievingXP)
 
 	if currentLevel < 55 {
 		return ArdyKnightResult{}, fmt.Errorf("current thieving level (%d, from XP %d) must be at least 55 to pickpocket Ardougne Knights effectively", currentLevel, currentThievingXP)
 	}
 
+	if err := xp.ValidateXP(targetThievingXP); err != nil {
+		return ArdyKnightResult{}, fmt.Errorf("invalid target thieving XP: %w", err)
+	}
+
 	if targetThievingXP <= currentThievingXP {
 		return ArdyKnightResult{}, fmt.Errorf("target thieving XP (%d) must be greater than current thieving XP (%d)", targetThievingXP, currentThievingXP)
 	}
@@ -111,23 +140,8 @@ func CalculateArdyKnightStats(
 		return ArdyKnightResult{}, fmt.Errorf("hourly pickpockets must be greater than 0")
 	}
 
-	baseChance := getArdyKnightBaseSuccessChance(currentLevel)
-	totalSuccessChance := baseChance
-
-	if HasArdyMed {
-		totalSuccessChance += ArdyHardBoost
-	}
-	if hasThievingCape {
-		totalSuccessChance += ThievingCapeBoost
-	}
-	if hasShadowVeil {
-		totalSuccessChance += ShadowVeilBoost
-	}
-	if currentLevel >= 99 && HasArdyMed && hasThievingCape && hasShadowVeil {
-		totalSuccessChance = math.Min(totalSuccessChance, 0.995)
-	} else {
-		totalSuccessChance = math.Min(totalSuccessChance, 1.0)
-	}
+	// Rates shown per hour are at the current level
+	totalSuccessChance := successChanceAt(currentLevel, HasArdyMed, hasThievingCape, hasShadowVeil)
 
 	failureRate := 1.0 - totalSuccessChance
 	effectiveXPPerAttempt := BaseXPPerPickpocket * totalSuccessChance
@@ -149,21 +163,21 @@ func CalculateArdyKnightStats(
 
 	xpToTarget := targetThievingXP - currentThievingXP
 
-	hoursToTarget := 0.0
-	pickpocketsToTarget := 0
-	if xpToTarget > 0 && xpPerHour > 0 {
-		hoursToTarget = float64(xpToTarget) / float64(xpPerHour)
-		if effectiveXPPerAttempt > 0 {
-			pickpocketsToTarget = int(math.Ceil(float64(xpToTarget) / effectiveXPPerAttempt))
-		} else if xpToTarget > 0 {
-
+	// The success chance improves as the grind goes on, so time is walked level by level
+	levelProgression, err := progression.Walk(currentThievingXP, targetThievingXP, func(level int) progression.Rate {
+		return progression.Rate{
+			XPPerAction:    BaseXPPerPickpocket,
+			ActionsPerHour: float64(hourlyPickpockets),
+			SuccessChance:  successChanceAt(level, HasArdyMed, hasThievingCape, hasShadowVeil),
 		}
-	} else if xpToTarget <= 0 {
-		xpToTarget = 0
-		hoursToTarget = 0
-		pickpocketsToTarget = 0
+	})
+	if err != nil {
+		return ArdyKnightResult{}, err
 	}
 
+	hoursToTarget := levelProgression.TotalHours
+	pickpocketsToTarget := int(math.Ceil(levelProgression.TotalActions))
+
 	return ArdyKnightResult{
 		CalculatedSuccessRate: totalSuccessChance,
 		EffectiveXPPerAttempt: effectiveXPPerAttempt,
@@ -180,7 +194,10 @@ func CalculateArdyKnightStats(
 		TargetTotalXP:        targetThievingXP,
 		XPToTarget:           xpToTarget,
 		HoursToTarget:        hoursToTarget,
+		AttemptsToTarget:     pickpocketsToTarget,
 		PickpocketsToTarget:  pickpocketsToTarget,
+
+		Progression: levelProgression,
 	}, nil
 }
 
@@ -189,7 +206,7 @@ func GetCalculationProTips() map[string]any {
 	return map[string]any{
 		"calculation_methodology": map[string]any{
 			"xp_rates_source": "Based on official success rates and community testing",
-			"base_formula":    "Success rate × Base XP (84.3) × Attempts per hour",
+			"base_formula":    "Success rate × Base XP (84.3) × Attempts per hour, with the success rate of each level along the way",
 			"data_points": []map[string]any{
 				{"level": 55, "xp_per_hour": 60000, "note": "Minimum access level"},
 				{"level": 70, "xp_per_hour": 90000, "note": "Improved success rate"},
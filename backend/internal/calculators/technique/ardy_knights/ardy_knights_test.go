@@ -184,6 +184,10 @@ func TestCalculateArdyKnightStats(t *testing.T) {
 			if result.HoursToTarget < 0 {
 				t.Errorf("Hours to target should not be negative: got %f", result.HoursToTarget)
 			}
+
+			if result.AttemptsToTarget != result.PickpocketsToTarget {
+				t.Errorf("Attempts to target should be the %d pickpockets to target, got %d", result.PickpocketsToTarget, result.AttemptsToTarget)
+			}
 		})
 	}
 }
@@ -274,3 +278,25 @@ func BenchmarkGetArdyKnightBaseSuccessChance(b *testing.B) {
 		getArdyKnightBaseSuccessChance(75)
 	}
 }
+
+func TestArdyKnightProgression(t *testing.T) {
+	// 55 to 99 without boosts: the success chance climbs from 65% to 97% along the way
+	result, err := CalculateArdyKnightStats(GetTotalXPForLevel(55), GetTotalXPForLevel(99), false, false, false, false, 1300, 20, 100)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	segments := result.Progression.Segments
+	if len(segments) != 44 {
+		t.Fatalf("Expected 44 level segments, got %d", len(segments))
+	}
+	if segments[0].SuccessChance >= segments[len(segments)-1].SuccessChance {
+		t.Errorf("Success chance should improve with level: %.2f to %.2f", segments[0].SuccessChance, segments[len(segments)-1].SuccessChance)
+	}
+
+	// Using only the starting success rate would overestimate the time needed
+	startingRateHours := float64(result.XPToTarget) / float64(result.XPHour)
+	if result.HoursToTarget >= startingRateHours {
+		t.Errorf("Level-by-level hours %.1f should be below the starting-rate estimate %.1f", result.HoursToTarget, startingRateHours)
+	}
+}
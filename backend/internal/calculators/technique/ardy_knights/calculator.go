@@ -0,0 +1,125 @@
+package ardyknights
+
+import (
+	"fmt"
+
+	"osrs-xp-kits/internal/calculators"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+// ArdyKnightInput is the request body accepted by the Ardougne Knights calculator.
+// Progress can be given either as total XP or as a level.
+type ArdyKnightInput struct {
+	CurrentThievingXP    *int `json:"current_thieving_xp,omitempty"`
+	CurrentThievingLevel *int `json:"current_thieving_level,omitempty"`
+
+	TargetThievingXP    *int `json:"target_thieving_xp,omitempty"`
+	TargetThievingLevel *int `json:"target_thieving_level,omitempty"`
+
+	HasArdyMed        bool `json:"has_ardy_med"`
+	HasThievingCape   bool `json:"has_thieving_cape"`
+	HasRoguesOutfit   bool `json:"has_rogues_outfit"`
+	HasShadowVeil     bool `json:"has_shadow_veil"`
+	HourlyPickpockets int  `json:"hourly_pickpockets"`
+	FoodHealAmount    int  `json:"food_heal_amount"`
+	FoodCost          int  `json:"food_cost"`
+}
+
+// Calculator exposes Ardougne Knight pickpocketing through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the Ardougne Knights calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "ardy_knights",
+		Name:        "Ardougne Knights",
+		Description: "Thieving XP, coins and food usage from pickpocketing Ardougne Knights",
+		Category:    "skilling",
+		Skills:      []string{"thieving"},
+	}
+}
+
+// Validate checks the Ardougne Knights input
+func (Calculator) Validate(input ArdyKnightInput) error {
+	currentXP, targetXP, err := input.resolveXP()
+	if err != nil {
+		return err
+	}
+	if targetXP <= currentXP {
+		return fmt.Errorf("target thieving XP (%d) must be greater than current thieving XP (%d)", targetXP, currentXP)
+	}
+	if input.HourlyPickpockets < 0 {
+		return fmt.Errorf("hourly pickpockets cannot be negative")
+	}
+	return nil
+}
+
+// Calculate runs the Ardougne Knights calculation.
+// DefaultPickpocketsPerHour is used when no hourly rate is given.
+func (Calculator) Calculate(input ArdyKnightInput, opts calculators.Options) (ArdyKnightResult, error) {
+	currentXP, targetXP, err := input.resolveXP()
+	if err != nil {
+		return ArdyKnightResult{}, err
+	}
+
+	hourlyPickpockets := input.HourlyPickpockets
+	if hourlyPickpockets == 0 {
+		hourlyPickpockets = DefaultPickpocketsPerHour
+	}
+
+	return CalculateArdyKnightStats(
+		currentXP,
+		targetXP,
+		input.HasArdyMed,
+		input.HasThievingCape,
+		input.HasRoguesOutfit,
+		input.HasShadowVeil,
+		hourlyPickpockets,
+		input.FoodHealAmount,
+		input.FoodCost,
+	)
+}
+
+// ProTips returns the Ardougne Knights calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
+
+// resolveXP returns the current and target XP, converting levels when XP is not given
+func (input ArdyKnightInput) resolveXP() (int, int, error) {
+	var currentXP, targetXP int
+
+	switch {
+	case input.CurrentThievingXP != nil:
+		currentXP = *input.CurrentThievingXP
+		if err := xp.ValidateXP(currentXP); err != nil {
+			return 0, 0, fmt.Errorf("invalid current thieving XP: %w", err)
+		}
+	case input.CurrentThievingLevel != nil:
+		level := *input.CurrentThievingLevel
+		if err := xp.ValidateLevel(level); err != nil {
+			return 0, 0, fmt.Errorf("invalid current thieving level: %w", err)
+		}
+		currentXP = GetTotalXPForLevel(level)
+	default:
+		return 0, 0, fmt.Errorf("either current_thieving_xp or current_thieving_level must be provided")
+	}
+
+	switch {
+	case input.TargetThievingXP != nil:
+		targetXP = *input.TargetThievingXP
+		if err := xp.ValidateXP(targetXP); err != nil {
+			return 0, 0, fmt.Errorf("invalid target thieving XP: %w", err)
+		}
+	case input.TargetThievingLevel != nil:
+		level := *input.TargetThievingLevel
+		if err := xp.ValidateLevel(level); err != nil {
+			return 0, 0, fmt.Errorf("invalid target thieving level: %w", err)
+		}
+		targetXP = GetTotalXPForLevel(level)
+	default:
+		return 0, 0, fmt.Errorf("either target_thieving_xp or target_thieving_level must be provided")
+	}
+
+	return currentXP, targetXP, nil
+}
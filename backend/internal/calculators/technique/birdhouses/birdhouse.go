@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"log"
 	"math"
+	"time"
+
+	"osrs-xp-kits/internal/calculators/tools"
 )
 
 type BirdhouseResult struct {
@@ -15,6 +18,10 @@ type BirdhouseResult struct {
 	DaysHighEff    int                       `json:"days_high_efficiency"`
 	SeedDrops      map[string]map[string]int `json:"seed_drops"`
 	TotalLoot      int                       `json:"total_loot"`
+	Seed           int64                     `json:"seed"`
+	ExpectedLoot   tools.ExpectedLoot        `json:"expected_loot"`
+
+	Distribution *tools.Distribution `json:"distribution,omitempty"`
 }
 
 var avgNests = map[string]float64{
@@ -52,6 +59,11 @@ func CalculateBirdhouseData(typ string, quantity int) (BirdhouseResult, error) {
 }
 
 func CalculateBirdhouseDataWithPrices(typ string, quantity int, livePrices map[string]int) (BirdhouseResult, error) {
+	return CalculateBirdhouseDataWithSeed(typ, quantity, livePrices, time.Now().UnixNano())
+}
+
+// CalculateBirdhouseDataWithSeed calculates birdhouse data with a specific nest loot seed so results can be reproduced
+func CalculateBirdhouseDataWithSeed(typ string, quantity int, livePrices map[string]int, seed int64) (BirdhouseResult, error) {
 	if quantity <= 0 {
 		return BirdhouseResult{}, fmt.Errorf("quantity must be positive, got %d", quantity)
 	}
@@ -78,7 +90,7 @@ func CalculateBirdhouseDataWithPrices(typ string, quantity int, livePrices map[s
 	runsFloat := math.Ceil(float64(quantity) / 4.0)
 	//runs := int(runsFloat)
 
-	seedDrops, totalLoot, err := SimulateNestLootWithPrices(int(math.Round(nests)), livePrices)
+	seedDrops, totalLoot, err := SimulateNestLootWithPricesAndSeed(int(math.Round(nests)), livePrices, seed)
 	if err != nil {
 		log.Fatalf("Simulation error: %v", err)
 	}
@@ -94,6 +106,8 @@ func CalculateBirdhouseDataWithPrices(typ string, quantity int, livePrices map[s
 		DaysHighEff:    int(math.Ceil(runsFloat / 14.0)), // 14 runs/day
 		SeedDrops:      seedDrops,
 		TotalLoot:      totalLoot + int(totalNestLoot),
+		Seed:           seed,
+		ExpectedLoot:   ExpectedNestLoot(nests, livePrices),
 	}, nil
 }
 
@@ -0,0 +1,22 @@
+package birdhouses
+
+import (
+	"context"
+
+	"osrs-xp-kits/internal/calculators/tools"
+)
+
+// SimulateNestLootDistribution runs the nest loot simulation for many independent trials and
+// summarises the total value and the count of every seed type
+func SimulateNestLootDistribution(ctx context.Context, nests int, livePrices map[string]int, trials int, seed int64) (tools.Distribution, error) {
+	return tools.SimulateDistribution(ctx, trials, nests, seed, func(trialSeed int64) tools.Trial {
+		results, totalValue, _ := SimulateNestLootWithPricesAndSeed(nests, livePrices, trialSeed)
+
+		items := make(map[string]int, len(results))
+		for key, data := range results {
+			items[key] = data["quantity"]
+		}
+
+		return tools.Trial{TotalValue: totalValue, Items: items}
+	})
+}
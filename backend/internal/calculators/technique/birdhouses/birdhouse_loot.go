@@ -3,6 +3,7 @@ package birdhouses
 import (
 	"fmt"
 	"osrs-xp-kits/internal/calculators/tools"
+	"time"
 )
 
 var NestTable = tools.DropTable{
@@ -32,23 +33,19 @@ func SimulateNestLoot(nests int) (map[string]map[string]int, int, error) {
 }
 
 func SimulateNestLootWithPrices(nests int, livePrices map[string]int) (map[string]map[string]int, int, error) {
+	return SimulateNestLootWithPricesAndSeed(nests, livePrices, time.Now().UnixNano())
+}
+
+// SimulateNestLootWithPricesAndSeed simulates nest loot with a specific seed for deterministic results
+func SimulateNestLootWithPricesAndSeed(nests int, livePrices map[string]int, seed int64) (map[string]map[string]int, int, error) {
 	if nests < 0 {
 		return nil, 0, fmt.Errorf("nests cannot be negative")
 	}
 
 	// Create a copy of NestTable with updated prices if live prices are provided
-	dropTable := make(tools.DropTable, len(NestTable))
-	copy(dropTable, NestTable)
-
-	if livePrices != nil {
-		for i, item := range dropTable {
-			if livePrice, exists := livePrices[item.Name]; exists {
-				dropTable[i].Price = livePrice
-			}
-		}
-	}
+	dropTable := NestTable.WithPrices(livePrices)
 
-	results, totalValue, err := tools.SimulateMultipleDrops(dropTable, nests)
+	results, totalValue, err := tools.SimulateMultipleDropsWithSeed(dropTable, nests, seed)
 	if err != nil {
 		fmt.Printf("Error during simulation: %v\n", err)
 		return nil, 0, err
@@ -56,3 +53,9 @@ func SimulateNestLootWithPrices(nests int, livePrices map[string]int) (map[strin
 
 	return results, totalValue, nil
 }
+
+// ExpectedNestLoot returns the exact expected seeds from searching the given number of nests.
+// Fractional nests are allowed since it is an average.
+func ExpectedNestLoot(nests float64, livePrices map[string]int) tools.ExpectedLoot {
+	return NestTable.WithPrices(livePrices).ExpectedCompound(nests, 0)
+}
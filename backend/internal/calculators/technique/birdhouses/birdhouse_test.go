@@ -1,6 +1,7 @@
 package birdhouses
 
 import (
+	"math"
 	"testing"
 )
 
@@ -269,3 +270,34 @@ func BenchmarkCalculateBirdhouseData(b *testing.B) {
 		CalculateBirdhouseData("yew", 100)
 	}
 }
+
+func TestExpectedNestLoot(t *testing.T) {
+	const nests = 250.0
+
+	expectedValue := 0.0
+	for _, item := range NestTable {
+		expectedValue += nests * item.Probability * float64(item.Price)
+	}
+
+	loot := ExpectedNestLoot(nests, nil)
+	if math.Abs(loot.TotalValue-expectedValue) > 1e-6 {
+		t.Errorf("Expected total value %.2f, got %.2f", expectedValue, loot.TotalValue)
+	}
+	if len(loot.Items) != len(NestTable) {
+		t.Errorf("Expected %d items, got %d", len(NestTable), len(loot.Items))
+	}
+
+	result, err := CalculateBirdhouseData("redwood", 100)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	again, _ := CalculateBirdhouseData("redwood", 100)
+	if result.ExpectedLoot.TotalValue != again.ExpectedLoot.TotalValue {
+		t.Errorf("Expected loot should be stable between requests: %.2f vs %.2f", result.ExpectedLoot.TotalValue, again.ExpectedLoot.TotalValue)
+	}
+
+	live := ExpectedNestLoot(nests, map[string]int{"Dragonfruit tree seed": 0})
+	if live.TotalValue >= loot.TotalValue {
+		t.Errorf("Zero live price should lower total value: %.2f vs %.2f", live.TotalValue, loot.TotalValue)
+	}
+}
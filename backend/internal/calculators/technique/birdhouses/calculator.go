@@ -0,0 +1,60 @@
+package birdhouses
+
+import (
+	"fmt"
+	"math"
+
+	"osrs-xp-kits/internal/calculators"
+)
+
+// BirdhouseInput is the request body accepted by the birdhouse calculator
+type BirdhouseInput struct {
+	Type     string `json:"type"`
+	Quantity int    `json:"quantity"`
+}
+
+// Calculator exposes birdhouse runs through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the birdhouse calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "birdhouses",
+		Name:        "Birdhouse Runs",
+		Description: "Hunter and Crafting XP plus bird nest loot from birdhouse runs on Fossil Island",
+		Category:    "skilling",
+		Skills:      []string{"hunter", "crafting"},
+	}
+}
+
+// Validate checks the birdhouse input
+func (Calculator) Validate(input BirdhouseInput) error {
+	if input.Quantity <= 0 {
+		return fmt.Errorf("quantity must be positive, got %d", input.Quantity)
+	}
+	if _, ok := avgNests[input.Type]; !ok {
+		return fmt.Errorf("unknown birdhouse type: %s", input.Type)
+	}
+	return nil
+}
+
+// Calculate runs the birdhouse calculation
+func (Calculator) Calculate(input BirdhouseInput, opts calculators.Options) (BirdhouseResult, error) {
+	result, err := CalculateBirdhouseDataWithSeed(input.Type, input.Quantity, opts.Prices, opts.Seed)
+	if err != nil || opts.Simulations == 0 {
+		return result, err
+	}
+
+	distribution, err := SimulateNestLootDistribution(opts.RequestContext(), int(math.Round(result.EstimatedNests)), opts.Prices, opts.Simulations, opts.Seed)
+	if err != nil {
+		return BirdhouseResult{}, err
+	}
+	result.Distribution = &distribution
+
+	return result, nil
+}
+
+// ProTips returns the birdhouse calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
@@ -0,0 +1,238 @@
+package blastfurnace
+
+import (
+	"fmt"
+	"math"
+
+	"osrs-xp-kits/internal/calculators/progression"
+	"osrs-xp-kits/internal/calculators/tools"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+type BlastFurnaceResult struct {
+	Bar          string `json:"bar"`
+	CurrentLevel int    `json:"current_level"`
+	TargetLevel  int    `json:"target_level"`
+	XPNeeded     int    `json:"xp_needed"`
+
+	XPPerBar     float64 `json:"xp_per_bar"`
+	BarsPerTrip  float64 `json:"bars_per_trip"`
+	TripsPerHour float64 `json:"trips_per_hour"`
+	BarsPerHour  float64 `json:"bars_per_hour"`
+	XPPerHour    float64 `json:"xp_per_hour"`
+
+	Ingredients     []Ingredient `json:"ingredients"` // Ore and coal for one bar
+	BarPrice        int          `json:"bar_price"`
+	ProfitPerBar    float64      `json:"profit_per_bar"` // Bar price minus ore and coal
+	OreCostPerHour  int          `json:"ore_cost_per_hour"`
+	StaminaPerHour  int          `json:"stamina_per_hour"`
+	FeesPerHour     int          `json:"fees_per_hour"` // Coffer and foreman
+	BarValuePerHour int          `json:"bar_value_per_hour"`
+	ProfitPerHour   int          `json:"profit_per_hour"`
+
+	BarsNeeded  int                     `json:"bars_needed"`
+	HoursNeeded float64                 `json:"hours_needed"`
+	TotalProfit int                     `json:"total_profit"` // Negative when reaching the target costs GP
+	GPPerXP     float64                 `json:"gp_per_xp"`    // Net cost per XP, negative when profitable
+	Progression progression.Progression `json:"progression"`
+}
+
+// Ingredient is an ore used for each bar
+type Ingredient struct {
+	Item     string `json:"item"`
+	Quantity int    `json:"quantity"`
+	Price    int    `json:"price"`
+}
+
+func CalculateBlastFurnaceData(input BlastFurnaceInput) (BlastFurnaceResult, error) {
+	return CalculateBlastFurnaceDataWithPrices(input, nil)
+}
+
+// CalculateBlastFurnaceDataWithPrices calculates Blast Furnace data with optional live ore and bar prices
+func CalculateBlastFurnaceDataWithPrices(input BlastFurnaceInput, livePrices map[string]int) (BlastFurnaceResult, error) {
+	bar, coalBag, err := validateInput(input)
+	if err != nil {
+		return BlastFurnaceResult{}, err
+	}
+
+	xpPerBar := bar.XP
+	if input.Bar == "gold" && input.GoldsmithGauntlets {
+		xpPerBar = GoldsmithGauntletsXP
+	}
+
+	// Each trip carries a full inventory and a full coal bag, deposited in the ratio the bar needs
+	barsPerTrip := float64(InventorySlots)
+	if bar.Coal > 0 && coalBag > 0 {
+		barsPerTrip = float64(InventorySlots-1+coalBag) / float64(1+bar.Coal)
+	} else if bar.Coal > 0 {
+		barsPerTrip = float64(InventorySlots) / float64(1+bar.Coal)
+	}
+
+	tripsPerHour := bar.TripsPerHour
+	if !input.Stamina {
+		tripsPerHour *= NoStaminaPaceMultiplier
+	}
+	if !input.IceGloves {
+		tripsPerHour *= NoIceGlovesPaceMultiplier
+	}
+	barsPerHour := barsPerTrip * tripsPerHour
+
+	ingredients := []Ingredient{{Item: bar.Ore, Quantity: 1, Price: tools.PriceOr(bar.Ore, bar.OreValue, livePrices)}}
+	if bar.Coal > 0 {
+		ingredients = append(ingredients, Ingredient{Item: "Coal", Quantity: bar.Coal, Price: tools.PriceOr("Coal", CoalValue, livePrices)})
+	}
+	orePerBar := 0
+	for _, ingredient := range ingredients {
+		orePerBar += ingredient.Quantity * ingredient.Price
+	}
+	barPrice := tools.PriceOr(bar.Name, bar.BarValue, livePrices)
+
+	staminaPerHour := 0
+	if input.Stamina {
+		staminaPerHour = StaminaPotionsPerHour * tools.PriceOr("Stamina potion(4)", StaminaPotionValue, livePrices)
+	}
+	feesPerHour := CofferPerHour
+	if input.CurrentLevel < ForemanFeeLevel {
+		feesPerHour += ForemanFeePerHour
+	}
+
+	oreCostPerHour := int(math.Round(float64(orePerBar) * barsPerHour))
+	barValuePerHour := int(math.Round(float64(barPrice) * barsPerHour))
+	profitPerHour := barValuePerHour - oreCostPerHour - staminaPerHour - feesPerHour
+
+	// The foreman stops charging at level 60, so walk level by level with one bar as the action
+	currentXP := xp.ForLevel(input.CurrentLevel)
+	targetXP := xp.ForLevel(input.TargetLevel)
+	levelProgression, err := progression.Walk(currentXP, targetXP, func(level int) progression.Rate {
+		return progression.Rate{
+			XPPerAction:    xpPerBar,
+			ActionsPerHour: barsPerHour,
+			SuccessChance:  1,
+		}
+	})
+	if err != nil {
+		return BlastFurnaceResult{}, err
+	}
+
+	totalProfit := 0.0
+	for _, segment := range levelProgression.Segments {
+		fees := float64(CofferPerHour + staminaPerHour)
+		if segment.Level < ForemanFeeLevel {
+			fees += ForemanFeePerHour
+		}
+		totalProfit += segment.Actions*float64(barPrice-orePerBar) - segment.Hours*fees
+	}
+
+	gpPerXP := 0.0
+	if levelProgression.TotalXP > 0 {
+		gpPerXP = -totalProfit / float64(levelProgression.TotalXP)
+	}
+
+	return BlastFurnaceResult{
+		Bar:             bar.Name,
+		CurrentLevel:    input.CurrentLevel,
+		TargetLevel:     input.TargetLevel,
+		XPNeeded:        targetXP - currentXP,
+		XPPerBar:        xpPerBar,
+		BarsPerTrip:     barsPerTrip,
+		TripsPerHour:    tripsPerHour,
+		BarsPerHour:     barsPerHour,
+		XPPerHour:       xpPerBar * barsPerHour,
+		Ingredients:     ingredients,
+		BarPrice:        barPrice,
+		ProfitPerBar:    float64(barPrice - orePerBar),
+		OreCostPerHour:  oreCostPerHour,
+		StaminaPerHour:  staminaPerHour,
+		FeesPerHour:     feesPerHour,
+		BarValuePerHour: barValuePerHour,
+		ProfitPerHour:   profitPerHour,
+		BarsNeeded:      int(math.Ceil(levelProgression.TotalActions)),
+		HoursNeeded:     levelProgression.TotalHours,
+		TotalProfit:     int(math.Round(totalProfit)),
+		GPPerXP:         gpPerXP,
+		Progression:     levelProgression,
+	}, nil
+}
+
+// validateInput checks the input and returns the bar and coal bag capacity
+func validateInput(input BlastFurnaceInput) (Bar, int, error) {
+	bar, exists := Bars[input.Bar]
+	if !exists {
+		return Bar{}, 0, fmt.Errorf("invalid bar type: %s", input.Bar)
+	}
+	if input.CurrentLevel < bar.Level {
+		return Bar{}, 0, fmt.Errorf("%s requires %d Smithing", bar.Name, bar.Level)
+	}
+	if input.TargetLevel < input.CurrentLevel {
+		return Bar{}, 0, fmt.Errorf("target level must be greater than or equal to current level")
+	}
+	if input.TargetLevel > xp.MaxVirtualLevel {
+		return Bar{}, 0, fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+
+	coalBagName := input.CoalBag
+	if coalBagName == "" {
+		coalBagName = "none"
+	}
+	coalBag, exists := CoalBags[coalBagName]
+	if !exists {
+		return Bar{}, 0, fmt.Errorf("invalid coal bag: %s", input.CoalBag)
+	}
+	return bar, coalBag, nil
+}
+
+// GetCalculationProTips provides detailed information about how Blast Furnace calculations work
+func GetCalculationProTips() map[string]any {
+	return map[string]any{
+		"calculation_methodology": map[string]any{
+			"xp_rates_source": "Bar XP and coal requirements from the OSRS Wiki",
+			"base_formula":    "Bars per hour = (ore carried + coal bag) ÷ (1 + coal per bar) × trips per hour",
+			"data_points": []map[string]any{
+				{"bar": "gold", "setup": "goldsmith gauntlets, ice gloves, stamina", "bars_per_hour": 5600, "xp_per_hour": 314720},
+				{"bar": "mithril", "setup": "coal bag, ice gloves, stamina", "bars_per_hour": 1710, "xp_per_hour": 51300},
+				{"bar": "rune", "setup": "coal bag, ice gloves, stamina", "bars_per_hour": 1026, "xp_per_hour": 51300},
+			},
+		},
+		"game_mechanics": map[string]any{
+			"coal":      "The Blast Furnace needs half the coal of a normal furnace",
+			"coffer":    "The coffer pays the stokers 72,000 coins per hour",
+			"foreman":   "Players below 60 Smithing pay the foreman 2,500 coins every 10 minutes",
+			"gold_bars": "Goldsmith gauntlets raise the XP for gold bars from 22.5 to 56.2",
+		},
+		"factors_considered": []string{
+			"Bar type and Smithing level",
+			"Coal bag size",
+			"Ice gloves and stamina potions (affect trips per hour)",
+			"Ore, coal, bar and stamina potion prices",
+			"Coffer and foreman fees",
+		},
+		"accuracy_notes": map[string]any{
+			"rates_vary": "Trips per hour depend on the world and clicking:",
+			"variance_factors": []string{
+				"Dwarves on busy worlds keep the furnace running",
+				"Missed clicks at the conveyor belt and bar dispenser",
+				"Bars left in the dispenser at the end of a session",
+			},
+			"calculation_basis": "Full trips at a steady pace with every ore smelted",
+		},
+		"pro_tips": []map[string]string{
+			{
+				"tip":         "Gold for XP",
+				"description": "Gold bars with goldsmith gauntlets give the fastest Smithing XP in the game",
+			},
+			{
+				"tip":         "Use a Coal Bag",
+				"description": "A coal bag carries 27 extra coal each trip, nearly doubling the bars made from coal-heavy ores",
+			},
+			{
+				"tip":         "Reach 60 First",
+				"description": "The foreman fee adds 15,000 coins per hour until 60 Smithing",
+			},
+		},
+		"reward_calculation": map[string]any{
+			"profit_per_hour": "Bar value - ore and coal cost - stamina potions - coffer and foreman fees",
+			"total_profit":    "Profit over every level walked, with the foreman fee dropped from 60 Smithing",
+			"gp_per_xp":       "Net cost ÷ XP gained",
+		},
+	}
+}
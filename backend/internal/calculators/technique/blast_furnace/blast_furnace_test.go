@@ -0,0 +1,135 @@
+package blastfurnace
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalculateBlastFurnaceData(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       BlastFurnaceInput
+		expectError bool
+	}{
+		{
+			name:  "Gold bars with gauntlets",
+			input: BlastFurnaceInput{CurrentLevel: 40, TargetLevel: 70, Bar: "gold", GoldsmithGauntlets: true, IceGloves: true, Stamina: true},
+		},
+		{
+			name:  "Rune bars with a coal bag",
+			input: BlastFurnaceInput{CurrentLevel: 85, TargetLevel: 99, Bar: "rune", CoalBag: "coal_bag", IceGloves: true, Stamina: true},
+		},
+		{
+			name:        "Level too low for the bar",
+			input:       BlastFurnaceInput{CurrentLevel: 60, TargetLevel: 70, Bar: "adamant"},
+			expectError: true,
+		},
+		{
+			name:        "Invalid coal bag",
+			input:       BlastFurnaceInput{CurrentLevel: 60, TargetLevel: 70, Bar: "mithril", CoalBag: "sack"},
+			expectError: true,
+		},
+		{
+			name:        "Invalid bar",
+			input:       BlastFurnaceInput{CurrentLevel: 60, TargetLevel: 70, Bar: "dragon"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculateBlastFurnaceData(tt.input)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result.BarsPerHour <= 0 || result.HoursNeeded <= 0 {
+				t.Errorf("Expected positive bars per hour and hours, got %f and %f", result.BarsPerHour, result.HoursNeeded)
+			}
+			if math.Abs(result.XPPerHour-result.XPPerBar*result.BarsPerHour) > 1e-6 {
+				t.Errorf("Expected XP per hour %f, got %f", result.XPPerBar*result.BarsPerHour, result.XPPerHour)
+			}
+		})
+	}
+}
+
+func TestGoldsmithGauntlets(t *testing.T) {
+	input := BlastFurnaceInput{CurrentLevel: 40, TargetLevel: 50, Bar: "gold", IceGloves: true}
+	without, err := CalculateBlastFurnaceData(input)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	input.GoldsmithGauntlets = true
+	with, err := CalculateBlastFurnaceData(input)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if without.XPPerBar != 22.5 || with.XPPerBar != GoldsmithGauntletsXP {
+		t.Errorf("Expected 22.5 and %.1f XP per gold bar, got %.1f and %.1f", GoldsmithGauntletsXP, without.XPPerBar, with.XPPerBar)
+	}
+	if with.BarsNeeded >= without.BarsNeeded {
+		t.Errorf("Expected fewer bars with goldsmith gauntlets")
+	}
+}
+
+func TestCoalBag(t *testing.T) {
+	input := BlastFurnaceInput{CurrentLevel: 70, TargetLevel: 80, Bar: "adamant", IceGloves: true, Stamina: true}
+	without, err := CalculateBlastFurnaceData(input)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	input.CoalBag = "coal_bag"
+	with, err := CalculateBlastFurnaceData(input)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Adamant needs 3 coal per ore at the Blast Furnace
+	if without.BarsPerTrip != 7 || with.BarsPerTrip != float64(27+27)/4 {
+		t.Errorf("Expected 7 and %.2f bars per trip, got %.2f and %.2f", float64(27+27)/4, without.BarsPerTrip, with.BarsPerTrip)
+	}
+}
+
+func TestForemanFee(t *testing.T) {
+	below, err := CalculateBlastFurnaceData(BlastFurnaceInput{CurrentLevel: 50, TargetLevel: 60, Bar: "mithril"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	above, err := CalculateBlastFurnaceData(BlastFurnaceInput{CurrentLevel: 60, TargetLevel: 70, Bar: "mithril"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if below.FeesPerHour != CofferPerHour+ForemanFeePerHour {
+		t.Errorf("Expected fees of %d below 60, got %d", CofferPerHour+ForemanFeePerHour, below.FeesPerHour)
+	}
+	if above.FeesPerHour != CofferPerHour {
+		t.Errorf("Expected fees of %d from 60, got %d", CofferPerHour, above.FeesPerHour)
+	}
+}
+
+func TestCalculateBlastFurnaceDataWithPrices(t *testing.T) {
+	input := BlastFurnaceInput{CurrentLevel: 60, TargetLevel: 70, Bar: "mithril", CoalBag: "coal_bag", IceGloves: true}
+	livePrices := map[string]int{"Mithril ore": 200, "Coal": 100, "Mithril bar": 500}
+	result, err := CalculateBlastFurnaceDataWithPrices(input, livePrices)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result.ProfitPerBar != 500-200-2*100 {
+		t.Errorf("Expected profit per bar 100, got %f", result.ProfitPerBar)
+	}
+	expected := result.BarValuePerHour - result.OreCostPerHour - result.StaminaPerHour - result.FeesPerHour
+	if result.ProfitPerHour != expected {
+		t.Errorf("Expected profit per hour %d, got %d", expected, result.ProfitPerHour)
+	}
+}
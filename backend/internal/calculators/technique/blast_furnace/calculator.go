@@ -0,0 +1,46 @@
+package blastfurnace
+
+import (
+	"osrs-xp-kits/internal/calculators"
+)
+
+// BlastFurnaceInput is the request body accepted by the Blast Furnace calculator
+type BlastFurnaceInput struct {
+	CurrentLevel       int    `json:"current_level"`
+	TargetLevel        int    `json:"target_level"`
+	Bar                string `json:"bar"`
+	GoldsmithGauntlets bool   `json:"goldsmith_gauntlets,omitempty"`
+	CoalBag            string `json:"coal_bag,omitempty"`
+	IceGloves          bool   `json:"ice_gloves,omitempty"`
+	Stamina            bool   `json:"stamina,omitempty"`
+}
+
+// Calculator exposes the Blast Furnace through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the Blast Furnace calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "blast_furnace",
+		Name:        "Blast Furnace",
+		Description: "Bars and XP per hour, ore, coal and coffer costs against bar value, and hours and GP to a target level at the Blast Furnace",
+		Category:    "minigame",
+		Skills:      []string{"smithing"},
+	}
+}
+
+// Validate checks the Blast Furnace input
+func (Calculator) Validate(input BlastFurnaceInput) error {
+	_, _, err := validateInput(input)
+	return err
+}
+
+// Calculate runs the Blast Furnace calculation
+func (Calculator) Calculate(input BlastFurnaceInput, opts calculators.Options) (BlastFurnaceResult, error) {
+	return CalculateBlastFurnaceDataWithPrices(input, opts.Prices)
+}
+
+// ProTips returns the Blast Furnace calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
@@ -0,0 +1,59 @@
+package blastfurnace
+
+// Bar is a bar that can be smelted at the Blast Furnace
+type Bar struct {
+	Name  string // Bar item name, used for live prices
+	Level int
+	XP    float64
+	Ore   string
+	// Coal is the coal per bar at the Blast Furnace, half of what a normal furnace needs
+	Coal int
+	// TripsPerHour is the pace with stamina potions; bars without coal need no coal bag trips
+	TripsPerHour float64
+	OreValue     int // Default prices when live prices are unavailable
+	BarValue     int
+}
+
+// Bars keyed by the name used in requests
+var Bars = map[string]Bar{
+	"iron":    {"Iron bar", 15, 12.5, "Iron ore", 0, 200, 100, 200},
+	"silver":  {"Silver bar", 20, 13.7, "Silver ore", 0, 200, 70, 130},
+	"steel":   {"Steel bar", 30, 17.5, "Iron ore", 1, 95, 100, 400},
+	"gold":    {"Gold bar", 40, 22.5, "Gold ore", 0, 200, 150, 170},
+	"mithril": {"Mithril bar", 50, 30, "Mithril ore", 2, 95, 180, 600},
+	"adamant": {"Adamantite bar", 70, 37.5, "Adamantite ore", 3, 95, 1100, 1900},
+	"rune":    {"Runite bar", 85, 50, "Runite ore", 4, 95, 10500, 12000},
+}
+
+// CoalBags keyed by the name used in requests, with the coal each one holds
+var CoalBags = map[string]int{
+	"none":          0,
+	"coal_bag":      27,
+	"smithing_cape": 36, // The coal bag holds more while wearing a Smithing cape
+}
+
+// Blast Furnace constants based on OSRS Wiki
+const (
+	CoalValue = 150
+
+	// InventorySlots is the inventory space for ore and the coal bag
+	InventorySlots = 28
+
+	// GoldsmithGauntletsXP replaces the gold bar XP while wearing goldsmith gauntlets
+	GoldsmithGauntletsXP = 56.2
+
+	// CofferPerHour is drained from the coffer while smelting
+	CofferPerHour = 72000
+
+	// ForemanFeeLevel is the Smithing level at which the foreman no longer needs paying
+	ForemanFeeLevel   = 60
+	ForemanFeePerHour = 15000 // 2,500 coins every 10 minutes
+
+	// Without ice gloves the bars are cooled with a bucket of water before taking them
+	NoIceGlovesPaceMultiplier = 0.85
+
+	// Without stamina potions the run energy lasts fewer trips
+	NoStaminaPaceMultiplier = 0.75
+	StaminaPotionsPerHour   = 6
+	StaminaPotionValue      = 6000
+)
@@ -0,0 +1,45 @@
+package chinchompas
+
+import (
+	"osrs-xp-kits/internal/calculators"
+)
+
+// ChinchompaInput is the request body accepted by the chinchompa calculator.
+// Black chinchompas are always hunted in the Wilderness.
+type ChinchompaInput struct {
+	CurrentLevel int    `json:"current_level"`
+	TargetLevel  int    `json:"target_level"`
+	Chinchompa   string `json:"chinchompa"` // grey, red (carnivorous) or black
+	Wilderness   bool   `json:"wilderness,omitempty"`
+	Traps        int    `json:"traps,omitempty"` // 0 lays as many traps as the level allows
+}
+
+// Calculator exposes box trap chinchompa hunting through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the chinchompa calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "chinchompas",
+		Name:        "Chinchompas",
+		Description: "Catches, Hunter XP and chinchompa income per hour from box trapping grey, red and black chinchompas",
+		Category:    "skilling",
+		Skills:      []string{"hunter"},
+	}
+}
+
+// Validate checks the chinchompa input
+func (Calculator) Validate(input ChinchompaInput) error {
+	_, err := validateInput(input)
+	return err
+}
+
+// Calculate runs the chinchompa calculation
+func (Calculator) Calculate(input ChinchompaInput, opts calculators.Options) (ChinchompaResult, error) {
+	return CalculateChinchompaDataWithPrices(input, opts.Prices)
+}
+
+// ProTips returns the chinchompa calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
@@ -0,0 +1,195 @@
+package chinchompas
+
+import (
+	"fmt"
+	"math"
+
+	"osrs-xp-kits/internal/calculators/progression"
+	"osrs-xp-kits/internal/calculators/tools"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+type ChinchompaResult struct {
+	Chinchompa   string `json:"chinchompa"`
+	CurrentLevel int    `json:"current_level"`
+	TargetLevel  int    `json:"target_level"`
+	XPNeeded     int    `json:"xp_needed"`
+	Wilderness   bool   `json:"wilderness"`
+
+	Traps          int     `json:"traps"`
+	CatchRate      float64 `json:"catch_rate"`
+	XPPerCatch     float64 `json:"xp_per_catch"`
+	CatchesPerHour float64 `json:"catches_per_hour"`
+	XPPerHour      float64 `json:"xp_per_hour"`
+
+	ChinchompaPrice int `json:"chinchompa_price"`
+	GPPerHour       int `json:"gp_per_hour"`
+
+	CatchesNeeded int     `json:"catches_needed"`
+	HoursNeeded   float64 `json:"hours_needed"`
+	TotalValue    int     `json:"total_value"`
+
+	RiskNotes   []string                `json:"risk_notes,omitempty"`
+	Progression progression.Progression `json:"progression"`
+}
+
+func CalculateChinchompaData(input ChinchompaInput) (ChinchompaResult, error) {
+	return CalculateChinchompaDataWithPrices(input, nil)
+}
+
+// CalculateChinchompaDataWithPrices calculates box trap data with optional live chinchompa prices
+func CalculateChinchompaDataWithPrices(input ChinchompaInput, livePrices map[string]int) (ChinchompaResult, error) {
+	chinchompa, err := validateInput(input)
+	if err != nil {
+		return ChinchompaResult{}, err
+	}
+	wilderness := input.Wilderness || chinchompa.WildernessOnly
+
+	trapsLaid := func(level int) int {
+		if input.Traps > 0 {
+			return min(input.Traps, TrapsAt(level, wilderness))
+		}
+		return TrapsAt(level, wilderness)
+	}
+
+	// More traps and a better catch chance come with level, so walk level by level
+	rate := func(level int) progression.Rate {
+		return progression.Rate{
+			XPPerAction:    chinchompa.XP,
+			ActionsPerHour: float64(trapsLaid(level) * AttemptsPerTrapPerHour),
+			SuccessChance:  CatchChance(level, chinchompa),
+		}
+	}
+
+	currentXP := xp.ForLevel(input.CurrentLevel)
+	targetXP := xp.ForLevel(input.TargetLevel)
+	levelProgression, err := progression.Walk(currentXP, targetXP, rate)
+	if err != nil {
+		return ChinchompaResult{}, err
+	}
+
+	current := rate(input.CurrentLevel)
+	catchesPerHour := current.ActionsPerHour * current.SuccessChance
+	price := tools.PriceOr(chinchompa.Name, chinchompa.Value, livePrices)
+	catchesNeeded := int(math.Ceil(float64(levelProgression.TotalXP) / chinchompa.XP))
+
+	var riskNotes []string
+	if chinchompa.WildernessOnly {
+		riskNotes = BlackChinchompaRiskNotes
+	}
+
+	return ChinchompaResult{
+		Chinchompa:      chinchompa.Name,
+		CurrentLevel:    input.CurrentLevel,
+		TargetLevel:     input.TargetLevel,
+		XPNeeded:        targetXP - currentXP,
+		Wilderness:      wilderness,
+		Traps:           trapsLaid(input.CurrentLevel),
+		CatchRate:       current.SuccessChance,
+		XPPerCatch:      chinchompa.XP,
+		CatchesPerHour:  catchesPerHour,
+		XPPerHour:       current.XPPerHour(),
+		ChinchompaPrice: price,
+		GPPerHour:       int(math.Round(catchesPerHour * float64(price))),
+		CatchesNeeded:   catchesNeeded,
+		HoursNeeded:     levelProgression.TotalHours,
+		TotalValue:      catchesNeeded * price,
+		RiskNotes:       riskNotes,
+		Progression:     levelProgression,
+	}, nil
+}
+
+// TrapsAt returns the number of box traps that can be laid at a Hunter level
+func TrapsAt(level int, wilderness bool) int {
+	traps := min(1+level/TrapsLevelStep, MaxTraps)
+	if wilderness {
+		traps += WildernessExtraTraps
+	}
+	return traps
+}
+
+// CatchChance returns the chance a box trap catches the chinchompa at a Hunter level
+func CatchChance(level int, chinchompa Chinchompa) float64 {
+	return math.Min(CatchMaxChance, CatchBaseChance+CatchChancePerLevel*float64(level-chinchompa.Level))
+}
+
+// validateInput checks the input and returns the chinchompa hunted
+func validateInput(input ChinchompaInput) (Chinchompa, error) {
+	chinchompa, exists := Chinchompas[input.Chinchompa]
+	if !exists {
+		return Chinchompa{}, fmt.Errorf("invalid chinchompa: %s", input.Chinchompa)
+	}
+	if err := xp.ValidateLevel(input.CurrentLevel); err != nil {
+		return Chinchompa{}, fmt.Errorf("invalid current hunter level: %w", err)
+	}
+	if input.CurrentLevel < chinchompa.Level {
+		return Chinchompa{}, fmt.Errorf("%s requires %d Hunter", chinchompa.Name, chinchompa.Level)
+	}
+	if input.TargetLevel < input.CurrentLevel {
+		return Chinchompa{}, fmt.Errorf("target level must be greater than or equal to current level")
+	}
+	if input.TargetLevel > xp.MaxVirtualLevel {
+		return Chinchompa{}, fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+
+	wilderness := input.Wilderness || chinchompa.WildernessOnly
+	if maxTraps := TrapsAt(input.CurrentLevel, wilderness); input.Traps < 0 || input.Traps > maxTraps {
+		return Chinchompa{}, fmt.Errorf("traps must be between 1 and %d at level %d", maxTraps, input.CurrentLevel)
+	}
+	return chinchompa, nil
+}
+
+// GetCalculationProTips provides detailed information about how chinchompa calculations work
+func GetCalculationProTips() map[string]any {
+	return map[string]any{
+		"calculation_methodology": map[string]any{
+			"xp_rates_source": "Chinchompa XP and trap limits from the OSRS Wiki, catch rates from community testing",
+			"base_formula":    "Catches per hour = traps × 120 checks per trap × catch chance, with the traps and catch chance of each level",
+			"data_points": []map[string]any{
+				{"level": 63, "chinchompa": "red", "traps": 4, "xp_per_hour": 76300},
+				{"level": 80, "chinchompa": "red", "traps": 5, "xp_per_hour": 117000},
+				{"level": 99, "chinchompa": "red", "traps": 5, "xp_per_hour": 141200},
+				{"level": 99, "chinchompa": "black", "traps": 6, "xp_per_hour": 183300},
+			},
+		},
+		"game_mechanics": map[string]any{
+			"traps":       "One box trap plus one more every 20 Hunter levels, up to 5",
+			"wilderness":  "One extra trap can be laid in the Wilderness",
+			"catch_rate":  "The catch chance grows with the levels above the chinchompa's requirement",
+			"carnivorous": "Red chinchompas are also known as carnivorous chinchompas",
+		},
+		"factors_considered": []string{
+			"Hunter level (affects traps and catch chance)",
+			"Chinchompa type",
+			"Wilderness extra trap",
+			"Chinchompa prices",
+		},
+		"accuracy_notes": map[string]any{
+			"rates_vary": "Catch rates depend on the hunter:",
+			"variance_factors": []string{
+				"Trap layout and tick-perfect resetting",
+				"Other hunters competing for the same spawns",
+				"Hopping and escaping player killers in the Wilderness",
+			},
+			"calculation_basis": "Every trap is checked and reset about every 30 seconds",
+		},
+		"pro_tips": []map[string]string{
+			{
+				"tip":         "Lay Traps in a Cross",
+				"description": "Chinchompas wander between traps, so keep every trap within a few tiles of the others",
+			},
+			{
+				"tip":         "Black Chinchompas Pay Best",
+				"description": "The extra Wilderness trap and high price make black chinchompas the best profit, at a risk",
+			},
+			{
+				"tip":         "Bank Often in the Wilderness",
+				"description": "Every chinchompa in the inventory is lost on death",
+			},
+		},
+		"reward_calculation": map[string]any{
+			"gp_per_hour": "Catches per hour × chinchompa price",
+			"total_value": "Chinchompas caught to reach the target × chinchompa price",
+		},
+	}
+}
@@ -0,0 +1,109 @@
+package chinchompas
+
+import (
+	"testing"
+)
+
+func TestCalculateChinchompaData(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       ChinchompaInput
+		expectError bool
+		wilderness  bool
+	}{
+		{
+			name:  "Grey chinchompas",
+			input: ChinchompaInput{CurrentLevel: 53, TargetLevel: 63, Chinchompa: "grey"},
+		},
+		{
+			name:  "Carnivorous chinchompas are red",
+			input: ChinchompaInput{CurrentLevel: 70, TargetLevel: 80, Chinchompa: "carnivorous"},
+		},
+		{
+			name:       "Black chinchompas are in the Wilderness",
+			input:      ChinchompaInput{CurrentLevel: 80, TargetLevel: 90, Chinchompa: "black"},
+			wilderness: true,
+		},
+		{
+			name:        "Level too low",
+			input:       ChinchompaInput{CurrentLevel: 60, TargetLevel: 70, Chinchompa: "red"},
+			expectError: true,
+		},
+		{
+			name:        "Too many traps",
+			input:       ChinchompaInput{CurrentLevel: 70, TargetLevel: 80, Chinchompa: "red", Traps: 5},
+			expectError: true,
+		},
+		{
+			name:        "Invalid chinchompa",
+			input:       ChinchompaInput{CurrentLevel: 70, TargetLevel: 80, Chinchompa: "blue"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculateChinchompaData(tt.input)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result.CatchesPerHour <= 0 || result.HoursNeeded <= 0 {
+				t.Errorf("Expected positive catches per hour and hours, got %f and %f", result.CatchesPerHour, result.HoursNeeded)
+			}
+			if result.Wilderness != tt.wilderness {
+				t.Errorf("Expected wilderness %v, got %v", tt.wilderness, result.Wilderness)
+			}
+			if tt.wilderness != (len(result.RiskNotes) > 0) {
+				t.Errorf("Risk notes should only be given for the Wilderness, got %d", len(result.RiskNotes))
+			}
+		})
+	}
+}
+
+func TestTrapsAt(t *testing.T) {
+	tests := []struct {
+		level      int
+		wilderness bool
+		expected   int
+	}{
+		{1, false, 1},
+		{19, false, 1},
+		{20, false, 2},
+		{60, false, 4},
+		{80, false, 5},
+		{99, false, 5},
+		{80, true, 6},
+		{53, true, 4},
+	}
+
+	for _, tt := range tests {
+		if got := TrapsAt(tt.level, tt.wilderness); got != tt.expected {
+			t.Errorf("TrapsAt(%d, %v) = %d, want %d", tt.level, tt.wilderness, got, tt.expected)
+		}
+	}
+}
+
+func TestTrapsAndCatchRateImproveWithLevel(t *testing.T) {
+	result, err := CalculateChinchompaData(ChinchompaInput{CurrentLevel: 63, TargetLevel: 99, Chinchompa: "red"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	segments := result.Progression.Segments
+	first, last := segments[0], segments[len(segments)-1]
+	if first.SuccessChance >= last.SuccessChance {
+		t.Errorf("Catch chance should improve with level: %.2f to %.2f", first.SuccessChance, last.SuccessChance)
+	}
+	if first.XPPerHour >= last.XPPerHour {
+		t.Errorf("XP per hour should improve with the fifth trap: %.0f to %.0f", first.XPPerHour, last.XPPerHour)
+	}
+}
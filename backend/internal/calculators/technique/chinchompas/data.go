@@ -0,0 +1,46 @@
+package chinchompas
+
+// Chinchompa is a chinchompa caught in box traps
+type Chinchompa struct {
+	Name  string // Item name, used for live prices
+	Level int
+	XP    float64
+	// WildernessOnly chinchompas are only found in the Wilderness
+	WildernessOnly bool
+	Value          int // Default price when live prices are unavailable
+}
+
+// Chinchompas keyed by the name used in requests.
+// Red chinchompas are also called carnivorous chinchompas.
+var Chinchompas = map[string]Chinchompa{
+	"grey":        {"Chinchompa", 53, 198.4, false, 1100},
+	"red":         {"Red chinchompa", 63, 265, false, 1500},
+	"carnivorous": {"Red chinchompa", 63, 265, false, 1500},
+	"black":       {"Black chinchompa", 73, 315, true, 2500},
+}
+
+// Box trap constants based on OSRS Wiki
+const (
+	// One extra trap can be laid every TrapsLevelStep Hunter levels, up to MaxTraps
+	TrapsLevelStep = 20
+	MaxTraps       = 5
+	// WildernessExtraTraps are allowed on top of the level limit in the Wilderness
+	WildernessExtraTraps = 1
+
+	// AttemptsPerTrapPerHour is how often each trap is checked and reset
+	AttemptsPerTrapPerHour = 120
+
+	// The catch chance grows with the levels above the chinchompa's requirement
+	CatchBaseChance     = 0.6
+	CatchChancePerLevel = 0.008
+	CatchMaxChance      = 0.95
+)
+
+// BlackChinchompaRiskNotes are reported when hunting black chinchompas
+var BlackChinchompaRiskNotes = []string{
+	"Black chinchompas live in level 32-36 Wilderness, where player killers are common",
+	"Caught chinchompas stack in the inventory and are all lost on death",
+	"Bank often: every catch since the last bank trip is at risk",
+	"Bring only what you can afford to lose; the Protect Item prayer saves one stack",
+	"Traps left behind can be taken by other hunters if you log out or die",
+}
@@ -0,0 +1,48 @@
+package fishing
+
+import (
+	"osrs-xp-kits/internal/calculators"
+)
+
+// FishingInput is the request body accepted by the fishing methods calculator.
+// The levels of the secondary skills stay as given while Fishing is trained.
+type FishingInput struct {
+	CurrentLevel  int    `json:"current_level"` // Fishing
+	TargetLevel   int    `json:"target_level"`  // Fishing
+	Method        string `json:"method"`        // aerial, drift_net or barbarian
+	HunterLevel   int    `json:"hunter_level,omitempty"`
+	AgilityLevel  int    `json:"agility_level,omitempty"`
+	StrengthLevel int    `json:"strength_level,omitempty"`
+	CutFish       bool   `json:"cut_fish,omitempty"`    // Aerial: cut catches into bait for Cooking XP
+	LootNets      bool   `json:"loot_nets,omitempty"`   // Drift net: loot the fish instead of releasing them
+	SharedNets    bool   `json:"shared_nets,omitempty"` // Drift net: share nets with other divers
+}
+
+// Calculator exposes aerial, drift net and barbarian fishing through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the fishing methods calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "fishing_methods",
+		Name:        "Aerial, Drift Net and Barbarian Fishing",
+		Description: "Fishing XP with the Hunter, Cooking, Agility and Strength XP gained alongside it, to a target Fishing level",
+		Category:    "skilling",
+		Skills:      []string{"fishing", "hunter", "cooking", "agility", "strength"},
+	}
+}
+
+// Validate checks the fishing methods input
+func (Calculator) Validate(input FishingInput) error {
+	return validateInput(input)
+}
+
+// Calculate runs the fishing methods calculation
+func (Calculator) Calculate(input FishingInput, opts calculators.Options) (FishingResult, error) {
+	return CalculateFishingDataWithPrices(input, opts.Prices)
+}
+
+// ProTips returns the fishing methods calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
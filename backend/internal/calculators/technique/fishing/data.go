@@ -0,0 +1,81 @@
+package fishing
+
+// Method is a fishing method that trains a secondary skill alongside Fishing
+type Method string
+
+const (
+	MethodAerial    Method = "aerial"
+	MethodDriftNet  Method = "drift_net"
+	MethodBarbarian Method = "barbarian"
+)
+
+// Fish is a catch of aerial or barbarian fishing. Every fish the player's levels
+// allow is equally likely on each catch.
+type Fish struct {
+	Name string
+	// Levels are the skill levels needed to catch the fish, Fishing included
+	Levels map[string]int
+	// XP is the XP for one catch in each skill, Fishing included
+	XP map[string]float64
+}
+
+// AerialFish in order of level. Cooking XP is only gained when the fish is cut for bait.
+var AerialFish = []Fish{
+	{"Bluegill", map[string]int{"fishing": 43, "hunter": 35}, map[string]float64{"fishing": 16.5, "hunter": 11.5, "cooking": 10}},
+	{"Common tench", map[string]int{"fishing": 56, "hunter": 51}, map[string]float64{"fishing": 20, "hunter": 14, "cooking": 15}},
+	{"Mottled eel", map[string]int{"fishing": 73, "hunter": 68}, map[string]float64{"fishing": 33, "hunter": 23, "cooking": 20}},
+	{"Greater siren", map[string]int{"fishing": 91, "hunter": 87}, map[string]float64{"fishing": 43, "hunter": 27, "cooking": 25}},
+}
+
+// BarbarianFish in order of level
+var BarbarianFish = []Fish{
+	{"Leaping trout", map[string]int{"fishing": 48, "agility": 15, "strength": 15}, map[string]float64{"fishing": 50, "agility": 5, "strength": 5}},
+	{"Leaping salmon", map[string]int{"fishing": 58, "agility": 30, "strength": 30}, map[string]float64{"fishing": 70, "agility": 6, "strength": 6}},
+	{"Leaping sturgeon", map[string]int{"fishing": 70, "agility": 45, "strength": 45}, map[string]float64{"fishing": 80, "agility": 7, "strength": 7}},
+}
+
+// DriftNetLoot are the fish that can be looted from a drift net, each equally likely
+var DriftNetLoot = []struct {
+	Name  string // Item name, used for live prices
+	Value int    // Default price when live prices are unavailable
+}{
+	{"Raw tuna", 100},
+	{"Raw swordfish", 300},
+	{"Raw shark", 800},
+}
+
+// Aerial fishing constants based on OSRS Wiki
+const (
+	AerialMinimumFishing = 43
+	AerialMinimumHunter  = 35
+	AerialCatchesPerHour = 1000
+
+	// MolchPearlChance and GoldenTenchChance are rolled on every aerial catch
+	MolchPearlChance  = 1.0 / 100
+	MolchPearlValue   = 2000
+	GoldenTenchChance = 1.0 / 20000
+)
+
+// Drift net constants based on OSRS Wiki. XP per fish grows with the Fishing and Hunter levels.
+const (
+	DriftNetMinimumFishing = 47
+	DriftNetMinimumHunter  = 44
+
+	DriftNetFishingXPPerLevel = 1.75
+	DriftNetHunterXPPerLevel  = 1.25
+
+	FishPerNet          = 10
+	DriftNetNetsPerHour = 40
+	// LootPaceMultiplier is the pace when looting the net instead of releasing the fish
+	LootPaceMultiplier = 0.85
+	// SharedPaceMultiplier is the pace when other divers herd fish into the same nets
+	SharedPaceMultiplier = 1.3
+)
+
+// Barbarian fishing constants based on OSRS Wiki
+const (
+	BarbarianMinimumFishing  = 48
+	BarbarianMinimumAgility  = 15
+	BarbarianMinimumStrength = 15
+	BarbarianCatchesPerHour  = 850
+)
@@ -0,0 +1,309 @@
+package fishing
+
+import (
+	"fmt"
+	"math"
+
+	"osrs-xp-kits/internal/calculators/probability"
+	"osrs-xp-kits/internal/calculators/progression"
+	"osrs-xp-kits/internal/calculators/tools"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+type FishingResult struct {
+	Method       string `json:"method"`
+	CurrentLevel int    `json:"current_level"`
+	TargetLevel  int    `json:"target_level"`
+	XPNeeded     int    `json:"xp_needed"`
+
+	Catches        []CatchChance      `json:"catches,omitempty"` // Fish caught at the current level
+	XPPerCatch     map[string]float64 `json:"xp_per_catch"`
+	CatchesPerHour float64            `json:"catches_per_hour"`
+	XPPerHour      map[string]float64 `json:"xp_per_hour"`
+
+	CatchesNeeded int                `json:"catches_needed"`
+	HoursNeeded   float64            `json:"hours_needed"`
+	TotalXP       map[string]float64 `json:"total_xp"` // Every skill, gained on the way to the target
+
+	GPPerHour   int                     `json:"gp_per_hour"`  // Looted fish and molch pearls
+	MolchPearls float64                 `json:"molch_pearls"` // Expected by the target
+	UniqueOdds  []probability.DropOdds  `json:"unique_odds"`
+	Progression progression.Progression `json:"progression"`
+}
+
+// CatchChance is the chance of a fish on each catch
+type CatchChance struct {
+	Fish   string  `json:"fish"`
+	Chance float64 `json:"chance"`
+}
+
+// UniqueDropOdds returns the odds of getting the Golden tench
+func (r FishingResult) UniqueDropOdds() []probability.DropOdds {
+	return r.UniqueOdds
+}
+
+func CalculateFishingData(input FishingInput) (FishingResult, error) {
+	return CalculateFishingDataWithPrices(input, nil)
+}
+
+// CalculateFishingDataWithPrices calculates multi-skill fishing data with optional live fish and pearl prices
+func CalculateFishingDataWithPrices(input FishingInput, livePrices map[string]int) (FishingResult, error) {
+	if err := validateInput(input); err != nil {
+		return FishingResult{}, err
+	}
+	method := Method(input.Method)
+	catchesPerHour := CatchesPerHour(input)
+
+	// Aerial and barbarian fish unlock with level and drift net XP grows with it, so walk level by level
+	currentXP := xp.ForLevel(input.CurrentLevel)
+	targetXP := xp.ForLevel(input.TargetLevel)
+	levelProgression, err := progression.Walk(currentXP, targetXP, func(level int) progression.Rate {
+		return progression.Rate{
+			XPPerAction:    XPPerCatch(input, level)["fishing"],
+			ActionsPerHour: catchesPerHour,
+			SuccessChance:  1,
+		}
+	})
+	if err != nil {
+		return FishingResult{}, err
+	}
+
+	// Every segment's catches also give the secondary XP of that level
+	totalXP := map[string]float64{}
+	for _, segment := range levelProgression.Segments {
+		for skill, amount := range XPPerCatch(input, min(segment.Level, xp.MaxLevel)) {
+			totalXP[skill] += amount * segment.Actions
+		}
+	}
+
+	xpPerCatch := XPPerCatch(input, input.CurrentLevel)
+	xpPerHour := make(map[string]float64, len(xpPerCatch))
+	for skill, amount := range xpPerCatch {
+		xpPerHour[skill] = amount * catchesPerHour
+	}
+
+	var catches []CatchChance
+	fish := availableFish(input, input.CurrentLevel)
+	for _, f := range fish {
+		catches = append(catches, CatchChance{Fish: f.Name, Chance: 1 / float64(len(fish))})
+	}
+
+	gpPerCatch := 0.0
+	molchPearls := 0.0
+	uniqueOdds := []probability.DropOdds{}
+	catchesNeeded := int(math.Ceil(levelProgression.TotalActions))
+	switch method {
+	case MethodAerial:
+		gpPerCatch = MolchPearlChance * float64(tools.PriceOr("Molch pearl", MolchPearlValue, livePrices))
+		molchPearls = MolchPearlChance * levelProgression.TotalActions
+		uniqueOdds, err = probability.UniqueOdds([]probability.Unique{{Name: "Golden tench", Rate: GoldenTenchChance}}, catchesNeeded)
+		if err != nil {
+			return FishingResult{}, err
+		}
+	case MethodDriftNet:
+		if input.LootNets {
+			total := 0
+			for _, loot := range DriftNetLoot {
+				total += tools.PriceOr(loot.Name, loot.Value, livePrices)
+			}
+			gpPerCatch = float64(total) / float64(len(DriftNetLoot))
+		}
+	}
+
+	return FishingResult{
+		Method:         input.Method,
+		CurrentLevel:   input.CurrentLevel,
+		TargetLevel:    input.TargetLevel,
+		XPNeeded:       targetXP - currentXP,
+		Catches:        catches,
+		XPPerCatch:     xpPerCatch,
+		CatchesPerHour: catchesPerHour,
+		XPPerHour:      xpPerHour,
+		CatchesNeeded:  catchesNeeded,
+		HoursNeeded:    levelProgression.TotalHours,
+		TotalXP:        totalXP,
+		GPPerHour:      int(math.Round(gpPerCatch * catchesPerHour)),
+		MolchPearls:    molchPearls,
+		UniqueOdds:     uniqueOdds,
+		Progression:    levelProgression,
+	}, nil
+}
+
+// CatchesPerHour returns the fish caught per hour with the input's method and choices
+func CatchesPerHour(input FishingInput) float64 {
+	switch Method(input.Method) {
+	case MethodAerial:
+		return AerialCatchesPerHour
+	case MethodDriftNet:
+		nets := float64(DriftNetNetsPerHour)
+		if input.LootNets {
+			nets *= LootPaceMultiplier
+		}
+		if input.SharedNets {
+			nets *= SharedPaceMultiplier
+		}
+		return nets * FishPerNet
+	case MethodBarbarian:
+		return BarbarianCatchesPerHour
+	}
+	return 0
+}
+
+// XPPerCatch returns the average XP per catch in each skill at a Fishing level
+func XPPerCatch(input FishingInput, fishingLevel int) map[string]float64 {
+	if Method(input.Method) == MethodDriftNet {
+		return map[string]float64{
+			"fishing": DriftNetFishingXPPerLevel * float64(fishingLevel),
+			"hunter":  DriftNetHunterXPPerLevel * float64(input.HunterLevel),
+		}
+	}
+
+	fish := availableFish(input, fishingLevel)
+	perCatch := map[string]float64{}
+	for _, f := range fish {
+		for skill, amount := range f.XP {
+			if skill == "cooking" && !input.CutFish {
+				continue
+			}
+			perCatch[skill] += amount / float64(len(fish))
+		}
+	}
+	return perCatch
+}
+
+// availableFish returns the aerial or barbarian fish the levels allow, none for drift nets
+func availableFish(input FishingInput, fishingLevel int) []Fish {
+	var pool []Fish
+	levels := map[string]int{"fishing": fishingLevel}
+	switch Method(input.Method) {
+	case MethodAerial:
+		pool = AerialFish
+		levels["hunter"] = input.HunterLevel
+	case MethodBarbarian:
+		pool = BarbarianFish
+		levels["agility"] = input.AgilityLevel
+		levels["strength"] = input.StrengthLevel
+	}
+
+	var fish []Fish
+	for _, f := range pool {
+		unlocked := true
+		for skill, required := range f.Levels {
+			if levels[skill] < required {
+				unlocked = false
+			}
+		}
+		if unlocked {
+			fish = append(fish, f)
+		}
+	}
+	return fish
+}
+
+// validateInput checks the method and the levels it requires
+func validateInput(input FishingInput) error {
+	if err := xp.ValidateLevel(input.CurrentLevel); err != nil {
+		return fmt.Errorf("invalid current fishing level: %w", err)
+	}
+	if input.TargetLevel < input.CurrentLevel {
+		return fmt.Errorf("target level must be greater than or equal to current level")
+	}
+	if input.TargetLevel > xp.MaxVirtualLevel {
+		return fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+
+	var requirements []requirement
+	switch Method(input.Method) {
+	case MethodAerial:
+		requirements = []requirement{
+			{"Fishing", input.CurrentLevel, AerialMinimumFishing},
+			{"Hunter", input.HunterLevel, AerialMinimumHunter},
+		}
+	case MethodDriftNet:
+		requirements = []requirement{
+			{"Fishing", input.CurrentLevel, DriftNetMinimumFishing},
+			{"Hunter", input.HunterLevel, DriftNetMinimumHunter},
+		}
+	case MethodBarbarian:
+		requirements = []requirement{
+			{"Fishing", input.CurrentLevel, BarbarianMinimumFishing},
+			{"Agility", input.AgilityLevel, BarbarianMinimumAgility},
+			{"Strength", input.StrengthLevel, BarbarianMinimumStrength},
+		}
+	default:
+		return fmt.Errorf("invalid method: %s", input.Method)
+	}
+
+	for _, req := range requirements {
+		if req.level > xp.MaxLevel {
+			return fmt.Errorf("%s level must be at most %d", req.skill, xp.MaxLevel)
+		}
+		if req.level < req.required {
+			return fmt.Errorf("%s fishing requires %d %s", input.Method, req.required, req.skill)
+		}
+	}
+	return nil
+}
+
+// requirement is a skill level a method needs
+type requirement struct {
+	skill    string
+	level    int
+	required int
+}
+
+// GetCalculationProTips provides detailed information about how the fishing methods calculations work
+func GetCalculationProTips() map[string]any {
+	return map[string]any{
+		"calculation_methodology": map[string]any{
+			"xp_rates_source": "Fish XP and requirements from the OSRS Wiki, catch rates from community testing",
+			"base_formula":    "XP per hour in each skill = average XP per catch of the fish unlocked × catches per hour",
+			"data_points": []map[string]any{
+				{"method": "aerial", "level": 91, "setup": "cutting fish", "fishing": 28100, "hunter": 18900, "cooking": 17500},
+				{"method": "drift_net", "level": 99, "hunter_level": 99, "setup": "looting nets", "fishing": 58900, "hunter": 42100},
+				{"method": "barbarian", "level": 70, "fishing": 56700, "agility": 5100, "strength": 5100},
+			},
+		},
+		"game_mechanics": map[string]any{
+			"aerial":    "A cormorant catches bluegill, tench, eels and sirens; cutting them into bait gives Cooking XP",
+			"drift_net": "Fish herded into drift nets give Fishing XP by Fishing level and Hunter XP by Hunter level",
+			"barbarian": "Leaping fish give a little Agility and Strength XP with every catch",
+			"unlocks":   "Each new fish also needs the secondary skill level, which stays as given while Fishing is trained",
+		},
+		"factors_considered": []string{
+			"Fishing level and the secondary skill levels",
+			"Fish unlocked at each Fishing level",
+			"Cutting aerial catches for bait",
+			"Looting or releasing drift net fish, and sharing nets",
+			"Molch pearl and fish prices",
+		},
+		"accuracy_notes": map[string]any{
+			"rates_vary": "Catch rates depend on the player:",
+			"variance_factors": []string{
+				"Tick manipulation for barbarian fishing",
+				"Fishing spot movement",
+				"Other divers at the drift nets",
+			},
+			"calculation_basis": "Every unlocked fish is equally likely on each catch",
+		},
+		"pro_tips": []map[string]string{
+			{
+				"tip":         "Aerial for Three Skills",
+				"description": "Cutting aerial catches into bait trains Fishing, Hunter and Cooking at once",
+			},
+			{
+				"tip":         "Raise Hunter for Drift Nets",
+				"description": "Drift net Hunter XP grows with the Hunter level, not the Fishing level",
+			},
+			{
+				"tip":         "Unlock Sturgeon",
+				"description": "45 Agility and Strength unlock leaping sturgeon, the best barbarian catch",
+			},
+		},
+		"reward_calculation": map[string]any{
+			"molch_pearls": "Every aerial catch has a 1/100 chance of a molch pearl",
+			"golden_tench": "Every aerial catch has a 1/20,000 chance of the Golden tench",
+			"drift_net":    "Looted fish are valued at the average price of tuna, swordfish and sharks",
+		},
+	}
+}
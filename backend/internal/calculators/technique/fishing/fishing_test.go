@@ -0,0 +1,119 @@
+package fishing
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalculateFishingData(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       FishingInput
+		expectError bool
+		skills      []string
+	}{
+		{
+			name:   "Aerial fishing cutting fish",
+			input:  FishingInput{CurrentLevel: 60, TargetLevel: 80, Method: "aerial", HunterLevel: 70, CutFish: true},
+			skills: []string{"fishing", "hunter", "cooking"},
+		},
+		{
+			name:   "Aerial fishing without cutting",
+			input:  FishingInput{CurrentLevel: 60, TargetLevel: 80, Method: "aerial", HunterLevel: 70},
+			skills: []string{"fishing", "hunter"},
+		},
+		{
+			name:   "Shared drift nets",
+			input:  FishingInput{CurrentLevel: 60, TargetLevel: 70, Method: "drift_net", HunterLevel: 60, SharedNets: true},
+			skills: []string{"fishing", "hunter"},
+		},
+		{
+			name:   "Barbarian fishing",
+			input:  FishingInput{CurrentLevel: 60, TargetLevel: 75, Method: "barbarian", AgilityLevel: 45, StrengthLevel: 45},
+			skills: []string{"fishing", "agility", "strength"},
+		},
+		{
+			name:        "Hunter too low for aerial fishing",
+			input:       FishingInput{CurrentLevel: 60, TargetLevel: 70, Method: "aerial", HunterLevel: 20},
+			expectError: true,
+		},
+		{
+			name:        "Agility missing for barbarian fishing",
+			input:       FishingInput{CurrentLevel: 60, TargetLevel: 70, Method: "barbarian", StrengthLevel: 45},
+			expectError: true,
+		},
+		{
+			name:        "Invalid method",
+			input:       FishingInput{CurrentLevel: 60, TargetLevel: 70, Method: "karambwan"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculateFishingData(tt.input)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(result.TotalXP) != len(tt.skills) {
+				t.Errorf("Expected XP in %v, got %v", tt.skills, result.TotalXP)
+			}
+			for _, skill := range tt.skills {
+				if result.TotalXP[skill] <= 0 || result.XPPerHour[skill] <= 0 {
+					t.Errorf("Expected %s XP, got %f total and %f per hour", skill, result.TotalXP[skill], result.XPPerHour[skill])
+				}
+			}
+			if math.Abs(result.TotalXP["fishing"]-float64(result.XPNeeded)) > 1e-6 {
+				t.Errorf("Fishing XP %f should equal the XP needed %d", result.TotalXP["fishing"], result.XPNeeded)
+			}
+		})
+	}
+}
+
+func TestSecondaryLevelsUnlockFish(t *testing.T) {
+	low := FishingInput{CurrentLevel: 80, TargetLevel: 85, Method: "barbarian", AgilityLevel: 15, StrengthLevel: 15}
+	high := FishingInput{CurrentLevel: 80, TargetLevel: 85, Method: "barbarian", AgilityLevel: 45, StrengthLevel: 45}
+
+	lowResult, err := CalculateFishingData(low)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	highResult, err := CalculateFishingData(high)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(lowResult.Catches) != 1 || len(highResult.Catches) != 3 {
+		t.Errorf("Expected 1 and 3 fish, got %d and %d", len(lowResult.Catches), len(highResult.Catches))
+	}
+	if highResult.HoursNeeded >= lowResult.HoursNeeded {
+		t.Errorf("Unlocking salmon and sturgeon should be faster: %.1f vs %.1f hours", highResult.HoursNeeded, lowResult.HoursNeeded)
+	}
+}
+
+func TestAerialUniques(t *testing.T) {
+	result, err := CalculateFishingData(FishingInput{CurrentLevel: 91, TargetLevel: 99, Method: "aerial", HunterLevel: 99})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(result.UniqueOdds) != 1 || result.UniqueOdds[0].Name != "Golden tench" {
+		t.Fatalf("Expected Golden tench odds, got %+v", result.UniqueOdds)
+	}
+	if result.UniqueOdds[0].Attempts != result.CatchesNeeded {
+		t.Errorf("Golden tench odds should cover %d catches, got %d", result.CatchesNeeded, result.UniqueOdds[0].Attempts)
+	}
+	expectedPearls := MolchPearlChance * float64(result.CatchesNeeded)
+	if math.Abs(result.MolchPearls-expectedPearls) > 1 {
+		t.Errorf("Expected about %.0f molch pearls, got %.0f", expectedPearls, result.MolchPearls)
+	}
+}
@@ -0,0 +1,47 @@
+package giantsfoundry
+
+import (
+	"osrs-xp-kits/internal/calculators"
+)
+
+// GiantsFoundryInput is the request body accepted by the Giants' Foundry calculator.
+// Exactly one of Swords and TargetLevel must be set.
+type GiantsFoundryInput struct {
+	CurrentLevel       int      `json:"current_level"`
+	Swords             int      `json:"swords,omitempty"`
+	TargetLevel        int      `json:"target_level,omitempty"`
+	Metals             []string `json:"metals"`                    // Two different metals, e.g. ["mithril", "adamant"]
+	Efficiency         float64  `json:"efficiency,omitempty"`      // 0 uses the default efficiency
+	SwordsPerHour      float64  `json:"swords_per_hour,omitempty"` // 0 uses the default rate
+	StartingReputation int      `json:"starting_reputation,omitempty"`
+}
+
+// Calculator exposes Giants' Foundry through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the Giants' Foundry calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "giants_foundry",
+		Name:        "Giants' Foundry",
+		Description: "Sword quality, Smithing XP, reputation toward the Smiths' Uniform and colossal blade, and bar costs at the Giants' Foundry",
+		Category:    "minigame",
+		Skills:      []string{"smithing"},
+	}
+}
+
+// Validate checks the Giants' Foundry input
+func (Calculator) Validate(input GiantsFoundryInput) error {
+	_, err := validateInput(input)
+	return err
+}
+
+// Calculate runs the Giants' Foundry calculation
+func (Calculator) Calculate(input GiantsFoundryInput, opts calculators.Options) (GiantsFoundryResult, error) {
+	return CalculateGiantsFoundryDataWithPrices(input, opts.Prices)
+}
+
+// ProTips returns the Giants' Foundry calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
@@ -0,0 +1,54 @@
+package giantsfoundry
+
+// Metal is a bar type that can be melted into a sword's alloy
+type Metal struct {
+	Bar     string  // Bar item name, used for live prices
+	Quality float64 // Sword quality reached with a perfect alloy of this metal alone
+	Value   int     // Default bar price when live prices are unavailable
+}
+
+// Metals keyed by the name used in requests
+var Metals = map[string]Metal{
+	"bronze":  {"Bronze bar", 30, 150},
+	"iron":    {"Iron bar", 40, 250},
+	"steel":   {"Steel bar", 65, 550},
+	"mithril": {"Mithril bar", 95, 1300},
+	"adamant": {"Adamantite bar", 125, 2200},
+	"rune":    {"Runite bar", 155, 12000},
+}
+
+// ReputationReward is an item bought from Kovac with Foundry reputation
+type ReputationReward struct {
+	Name string `json:"name"`
+	Cost int    `json:"cost"` // Foundry reputation
+}
+
+// ReputationRewards in the order they are usually bought, the Smiths' Uniform first
+var ReputationRewards = []ReputationReward{
+	{"Smiths gloves", 3500},
+	{"Smiths boots", 3500},
+	{"Smiths trousers", 4000},
+	{"Smiths tunic", 4000},
+	{"Colossal blade", 7500},
+}
+
+// Giants' Foundry constants based on OSRS Wiki
+const (
+	MinimumSmithingLevel = 15
+
+	// Every sword is made from 28 bars of two different metals, split evenly
+	BarsPerSword   = 28
+	MetalsPerSword = 2
+
+	// DefaultEfficiency is the share of the alloy's quality kept while smithing the sword
+	DefaultEfficiency = 0.9
+
+	DefaultSwordsPerHour = 7
+	MaxSwordsPerHour     = 15
+
+	// XP per sword = (quality² / 73 + 1.5 × quality + 1) × XPMultiplier
+	XPMultiplier = 30
+
+	// MaxSwords stops a run toward a target level that could never finish
+	MaxSwords = 100000
+)
@@ -0,0 +1,253 @@
+package giantsfoundry
+
+import (
+	"fmt"
+	"math"
+
+	"osrs-xp-kits/internal/calculators/tools"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+type GiantsFoundryResult struct {
+	CurrentLevel       int              `json:"current_level"`
+	TargetLevel        int              `json:"target_level,omitempty"`
+	FinalLevel         int              `json:"final_level"`
+	Metals             []string         `json:"metals"`
+	Swords             int              `json:"swords"`
+	Quality            float64          `json:"quality"`
+	XPPerSword         float64          `json:"xp_per_sword"`
+	ReputationPerSword int              `json:"reputation_per_sword"`
+	TotalXP            float64          `json:"total_xp"`
+	TotalReputation    int              `json:"total_reputation"`
+	Bars               []BarCost        `json:"bars"`
+	CostPerSword       int              `json:"cost_per_sword"`
+	TotalCost          int              `json:"total_cost"`
+	GPPerXP            float64          `json:"gp_per_xp"`
+	SwordsPerHour      float64          `json:"swords_per_hour"`
+	XPPerHour          float64          `json:"xp_per_hour"`
+	Hours              float64          `json:"hours"`
+	Rewards            []RewardProgress `json:"rewards"`
+}
+
+// BarCost is the bars of one metal used over every sword
+type BarCost struct {
+	Bar      string `json:"bar"`
+	PerSword int    `json:"per_sword"`
+	Quantity int    `json:"quantity"`
+	Price    int    `json:"price"`
+	Cost     int    `json:"cost"`
+}
+
+// RewardProgress tracks when a reward can be bought, buying rewards in order
+type RewardProgress struct {
+	ReputationReward
+	CumulativeCost int     `json:"cumulative_cost"`
+	SwordsToAfford int     `json:"swords_to_afford"`
+	HoursToAfford  float64 `json:"hours_to_afford"`
+	Affordable     bool    `json:"affordable"`
+}
+
+func CalculateGiantsFoundryData(input GiantsFoundryInput) (GiantsFoundryResult, error) {
+	return CalculateGiantsFoundryDataWithPrices(input, nil)
+}
+
+// CalculateGiantsFoundryDataWithPrices calculates Giants' Foundry data with optional live bar prices.
+// It hands in input.Swords swords, or enough swords to reach input.TargetLevel when no sword count is given.
+func CalculateGiantsFoundryDataWithPrices(input GiantsFoundryInput, livePrices map[string]int) (GiantsFoundryResult, error) {
+	metals, err := validateInput(input)
+	if err != nil {
+		return GiantsFoundryResult{}, err
+	}
+
+	efficiency := input.Efficiency
+	if efficiency == 0 {
+		efficiency = DefaultEfficiency
+	}
+	swordsPerHour := input.SwordsPerHour
+	if swordsPerHour == 0 {
+		swordsPerHour = DefaultSwordsPerHour
+	}
+
+	alloyQuality := 0.0
+	for _, metal := range metals {
+		alloyQuality += metal.Quality / MetalsPerSword
+	}
+	quality := math.Floor(alloyQuality * efficiency)
+	xpPerSword := SwordXP(quality)
+
+	startXP := xp.ForLevel(input.CurrentLevel)
+	swords := input.Swords
+	if swords == 0 {
+		swords = int(math.Ceil(float64(xp.ForLevel(input.TargetLevel)-startXP) / xpPerSword))
+	}
+
+	var bars []BarCost
+	costPerSword := 0
+	for _, metal := range metals {
+		price := tools.PriceOr(metal.Bar, metal.Value, livePrices)
+		perSword := BarsPerSword / MetalsPerSword
+		bars = append(bars, BarCost{
+			Bar:      metal.Bar,
+			PerSword: perSword,
+			Quantity: perSword * swords,
+			Price:    price,
+			Cost:     perSword * swords * price,
+		})
+		costPerSword += perSword * price
+	}
+
+	totalXP := xpPerSword * float64(swords)
+	reputation := int(quality)
+
+	return GiantsFoundryResult{
+		CurrentLevel:       input.CurrentLevel,
+		TargetLevel:        input.TargetLevel,
+		FinalLevel:         xp.VirtualLevelForXP(min(startXP+int(totalXP), xp.MaxXP)),
+		Metals:             input.Metals,
+		Swords:             swords,
+		Quality:            quality,
+		XPPerSword:         xpPerSword,
+		ReputationPerSword: reputation,
+		TotalXP:            totalXP,
+		TotalReputation:    reputation*swords + input.StartingReputation,
+		Bars:               bars,
+		CostPerSword:       costPerSword,
+		TotalCost:          costPerSword * swords,
+		GPPerXP:            float64(costPerSword) / xpPerSword,
+		SwordsPerHour:      swordsPerHour,
+		XPPerHour:          xpPerSword * swordsPerHour,
+		Hours:              float64(swords) / swordsPerHour,
+		Rewards:            rewardProgress(reputation*swords+input.StartingReputation, input.StartingReputation, reputation, swordsPerHour),
+	}, nil
+}
+
+// SwordXP returns the Smithing XP for handing in a sword of the given quality
+func SwordXP(quality float64) float64 {
+	return (quality*quality/73 + 1.5*quality + 1) * XPMultiplier
+}
+
+// validateInput checks the input and returns the two metals of the alloy
+func validateInput(input GiantsFoundryInput) ([]Metal, error) {
+	if input.CurrentLevel < MinimumSmithingLevel || input.CurrentLevel > xp.MaxVirtualLevel {
+		return nil, fmt.Errorf("smithing level must be between %d and %d", MinimumSmithingLevel, xp.MaxVirtualLevel)
+	}
+
+	switch {
+	case input.Swords > 0 && input.TargetLevel > 0:
+		return nil, fmt.Errorf("give either a number of swords or a target level, not both")
+	case input.Swords > MaxSwords:
+		return nil, fmt.Errorf("swords must be at most %d", MaxSwords)
+	case input.Swords > 0:
+	case input.TargetLevel > 0:
+		if input.TargetLevel <= input.CurrentLevel {
+			return nil, fmt.Errorf("target level must be greater than current level")
+		}
+		if input.TargetLevel > xp.MaxVirtualLevel {
+			return nil, fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+		}
+	default:
+		return nil, fmt.Errorf("either swords or target level must be provided")
+	}
+
+	if len(input.Metals) != MetalsPerSword || input.Metals[0] == input.Metals[1] {
+		return nil, fmt.Errorf("a sword needs %d different metals", MetalsPerSword)
+	}
+	metals := make([]Metal, 0, MetalsPerSword)
+	for _, name := range input.Metals {
+		metal, exists := Metals[name]
+		if !exists {
+			return nil, fmt.Errorf("invalid metal: %s", name)
+		}
+		metals = append(metals, metal)
+	}
+
+	if input.Efficiency < 0 || input.Efficiency > 1 {
+		return nil, fmt.Errorf("efficiency must be between 0 and 1")
+	}
+	if input.SwordsPerHour < 0 || input.SwordsPerHour > MaxSwordsPerHour {
+		return nil, fmt.Errorf("swords per hour must be between 1 and %d", MaxSwordsPerHour)
+	}
+	if input.StartingReputation < 0 {
+		return nil, fmt.Errorf("starting reputation must not be negative")
+	}
+	return metals, nil
+}
+
+// rewardProgress buys the reputation rewards in order, starting from the reputation already held
+func rewardProgress(totalReputation, startingReputation, reputationPerSword int, swordsPerHour float64) []RewardProgress {
+	progress := make([]RewardProgress, 0, len(ReputationRewards))
+	cumulative := 0
+	for _, reward := range ReputationRewards {
+		cumulative += reward.Cost
+		swordsToAfford := 0
+		if missing := cumulative - startingReputation; missing > 0 && reputationPerSword > 0 {
+			swordsToAfford = (missing + reputationPerSword - 1) / reputationPerSword
+		}
+		progress = append(progress, RewardProgress{
+			ReputationReward: reward,
+			CumulativeCost:   cumulative,
+			SwordsToAfford:   swordsToAfford,
+			HoursToAfford:    float64(swordsToAfford) / swordsPerHour,
+			Affordable:       totalReputation >= cumulative,
+		})
+	}
+	return progress
+}
+
+// GetCalculationProTips provides detailed information about how Giants' Foundry calculations work
+func GetCalculationProTips() map[string]any {
+	return map[string]any{
+		"calculation_methodology": map[string]any{
+			"xp_rates_source": "Sword XP formula and reputation rewards from the OSRS Wiki",
+			"base_formula":    "XP per sword = (quality² ÷ 73 + 1.5 × quality + 1) × 30",
+			"data_points": []map[string]any{
+				{"metals": "steel + mithril", "quality": 72, "xp_per_sword": 5400, "xp_per_hour": 37803},
+				{"metals": "mithril + adamant", "quality": 99, "xp_per_sword": 8513, "xp_per_hour": 59590},
+				{"metals": "adamant + rune", "quality": 126, "xp_per_sword": 12224, "xp_per_hour": 85571},
+			},
+		},
+		"game_mechanics": map[string]any{
+			"alloy":      "Every sword uses 28 bars of two different metals; better metals raise the highest quality",
+			"quality":    "Sword quality drops with every mistake at the trip hammer, grindstone and polishing wheel",
+			"reputation": "Kovac gives Foundry reputation equal to the sword's quality",
+			"rewards": []string{
+				"The Smiths' Uniform speeds up Smithing actions",
+				"The colossal blade is a two-handed sword bought with reputation",
+			},
+		},
+		"factors_considered": []string{
+			"Metals in the alloy",
+			"Efficiency (share of the alloy's quality kept)",
+			"Bar prices",
+			"Swords per hour",
+		},
+		"accuracy_notes": map[string]any{
+			"rates_vary": "Quality depends on how well each sword is smithed:",
+			"variance_factors": []string{
+				"Kovac's commission bonus for matching the requested blade",
+				"Mistakes and overheating at each stage",
+				"Using smithed items instead of bars",
+			},
+			"calculation_basis": "Every sword is assumed to reach the same quality",
+		},
+		"pro_tips": []map[string]string{
+			{
+				"tip":         "Mithril and Adamant",
+				"description": "Mithril and adamant bars give strong XP for a moderate cost per sword",
+			},
+			{
+				"tip":         "Buy the Uniform First",
+				"description": "The Smiths' Uniform costs 15,000 reputation and speeds up every Smithing action",
+			},
+			{
+				"tip":         "Melt Smithed Items",
+				"description": "Platebodies and other smithed items often cost less per bar than the bars themselves",
+			},
+		},
+		"reward_calculation": map[string]any{
+			"reputation": "Sword quality × swords handed in",
+			"total_cost": "14 bars of each metal × bar price × swords",
+			"gp_per_xp":  "Bar cost per sword ÷ XP per sword",
+		},
+	}
+}
@@ -0,0 +1,144 @@
+package giantsfoundry
+
+import (
+	"math"
+	"testing"
+
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+func TestCalculateGiantsFoundryData(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       GiantsFoundryInput
+		expectError bool
+	}{
+		{
+			name:  "Ten mithril and adamant swords",
+			input: GiantsFoundryInput{CurrentLevel: 50, Swords: 10, Metals: []string{"mithril", "adamant"}},
+		},
+		{
+			name:  "Adamant and rune to 99",
+			input: GiantsFoundryInput{CurrentLevel: 85, TargetLevel: 99, Metals: []string{"adamant", "rune"}, Efficiency: 0.95},
+		},
+		{
+			name:        "Below level 15",
+			input:       GiantsFoundryInput{CurrentLevel: 10, Swords: 10, Metals: []string{"bronze", "iron"}},
+			expectError: true,
+		},
+		{
+			name:        "Same metal twice",
+			input:       GiantsFoundryInput{CurrentLevel: 50, Swords: 10, Metals: []string{"mithril", "mithril"}},
+			expectError: true,
+		},
+		{
+			name:        "Both swords and target level",
+			input:       GiantsFoundryInput{CurrentLevel: 50, Swords: 10, TargetLevel: 60, Metals: []string{"mithril", "adamant"}},
+			expectError: true,
+		},
+		{
+			name:        "Invalid metal",
+			input:       GiantsFoundryInput{CurrentLevel: 50, Swords: 10, Metals: []string{"mithril", "dragon"}},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculateGiantsFoundryData(tt.input)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if tt.input.Swords > 0 && result.Swords != tt.input.Swords {
+				t.Errorf("Expected %d swords, got %d", tt.input.Swords, result.Swords)
+			}
+			if tt.input.TargetLevel > 0 && result.FinalLevel < tt.input.TargetLevel {
+				t.Errorf("Expected to reach level %d, got %d", tt.input.TargetLevel, result.FinalLevel)
+			}
+
+			bars := 0
+			for _, bar := range result.Bars {
+				bars += bar.Quantity
+			}
+			if bars != result.Swords*BarsPerSword {
+				t.Errorf("Expected %d bars, got %d", result.Swords*BarsPerSword, bars)
+			}
+		})
+	}
+}
+
+func TestSwordXP(t *testing.T) {
+	// (100² / 73 + 150 + 1) × 30
+	expected := (10000.0/73 + 151) * 30
+	if got := SwordXP(100); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f XP, got %f", expected, got)
+	}
+	if SwordXP(120) <= SwordXP(100) {
+		t.Errorf("Expected higher quality to give more XP")
+	}
+}
+
+func TestTargetLevelSwords(t *testing.T) {
+	input := GiantsFoundryInput{CurrentLevel: 60, TargetLevel: 70, Metals: []string{"mithril", "adamant"}}
+	result, err := CalculateGiantsFoundryData(input)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	xpNeeded := float64(xp.ForLevel(70) - xp.ForLevel(60))
+	if result.TotalXP < xpNeeded || result.TotalXP-result.XPPerSword >= xpNeeded {
+		t.Errorf("Expected just enough swords for %f XP, got %d swords for %f XP", xpNeeded, result.Swords, result.TotalXP)
+	}
+}
+
+func TestReputationRewards(t *testing.T) {
+	input := GiantsFoundryInput{CurrentLevel: 70, Swords: 100, Metals: []string{"mithril", "adamant"}, StartingReputation: 1000}
+	result, err := CalculateGiantsFoundryData(input)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result.TotalReputation != 1000+100*result.ReputationPerSword {
+		t.Errorf("Expected %d reputation, got %d", 1000+100*result.ReputationPerSword, result.TotalReputation)
+	}
+
+	uniformCost := 0
+	for _, reward := range result.Rewards[:4] {
+		uniformCost += reward.Cost
+	}
+	tunic := result.Rewards[3]
+	if tunic.CumulativeCost != uniformCost {
+		t.Errorf("Expected the full uniform to cost %d, got %d", uniformCost, tunic.CumulativeCost)
+	}
+	if tunic.SwordsToAfford*result.ReputationPerSword < uniformCost-1000 {
+		t.Errorf("Expected %d swords to afford the uniform", tunic.SwordsToAfford)
+	}
+	if tunic.Affordable != (result.TotalReputation >= uniformCost) {
+		t.Errorf("Expected the uniform to be affordable only with enough reputation")
+	}
+}
+
+func TestCalculateGiantsFoundryDataWithPrices(t *testing.T) {
+	input := GiantsFoundryInput{CurrentLevel: 50, Swords: 5, Metals: []string{"mithril", "adamant"}}
+	livePrices := map[string]int{"Mithril bar": 1000, "Adamantite bar": 2000}
+	result, err := CalculateGiantsFoundryDataWithPrices(input, livePrices)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if expected := 14 * (1000 + 2000); result.CostPerSword != expected {
+		t.Errorf("Expected cost per sword %d, got %d", expected, result.CostPerSword)
+	}
+	if result.TotalCost != result.CostPerSword*5 {
+		t.Errorf("Expected total cost %d, got %d", result.CostPerSword*5, result.TotalCost)
+	}
+}
@@ -0,0 +1,66 @@
+package gotr
+
+import (
+	"fmt"
+
+	"osrs-xp-kits/internal/calculators"
+)
+
+// GOTRInput is the request body accepted by the GOTR calculator
+type GOTRInput struct {
+	CurrentLevel int `json:"current_level"`
+	TargetLevel  int `json:"target_level"`
+}
+
+// Calculator exposes Guardians of the Rift through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the GOTR calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "gotr",
+		Name:        "Guardians of the Rift",
+		Description: "Runecrafting XP, reward searches and loot from Guardians of the Rift",
+		Category:    "minigame",
+		Skills:      []string{"runecrafting"},
+	}
+}
+
+// Validate checks the GOTR input
+func (Calculator) Validate(input GOTRInput) error {
+	if input.CurrentLevel < 27 || input.CurrentLevel > 126 {
+		return fmt.Errorf("current level must be between 27 and 126 (minimum level to access GOTR)")
+	}
+	if input.TargetLevel < 27 || input.TargetLevel > 126 {
+		return fmt.Errorf("target level must be between 27 and 126")
+	}
+	if input.TargetLevel <= input.CurrentLevel {
+		return fmt.Errorf("target level must be higher than current level")
+	}
+	return nil
+}
+
+// Calculate runs the GOTR calculation
+func (Calculator) Calculate(input GOTRInput, opts calculators.Options) (GOTRResult, error) {
+	result, err := CalculateGOTRData(input.CurrentLevel, input.TargetLevel)
+	if err != nil {
+		return result, err
+	}
+	result.Seed = opts.Seed
+	if opts.Simulations == 0 {
+		return result, nil
+	}
+
+	distribution, err := SimulateRewardsDistribution(opts.RequestContext(), result.TotalRewardRolls, opts.Simulations, opts.Seed)
+	if err != nil {
+		return GOTRResult{}, err
+	}
+	result.Distribution = &distribution
+
+	return result, nil
+}
+
+// ProTips returns the GOTR calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
@@ -1,5 +1,7 @@
 package gotr
 
+import "osrs-xp-kits/internal/calculators/pets"
+
 // GOTR Constants based on OSRS Wiki mechanics
 const (
 	// Game timing - based on efficient GOTR gameplay
@@ -16,16 +18,16 @@ const (
 	MaxEnergyPerGame = 1200.0 // maximum energy per game
 	PointsPerEnergy  = 0.01   // 1 point per 100 energy
 
-	// Pet mechanics - corrected based on wiki
-	// Note: Wiki states "Rift guardian pet cannot be obtained during the minigame"
-	// But there may be other pets available, so keeping a generic pet rate
-	PetRatePerSearch = 1.0 / 5000.0 // Generic pet rate (if applicable)
-
 	// Guardian stone requirements
 	GuardianStonePerPlayer = 250.0
 	GuardianStoneScaling   = 200.0 // for 20+ players (20% penalty)
 )
 
+// Pet is rolled once per reward search, with better odds at higher Runecraft levels.
+// Note: Wiki states "Rift guardian pet cannot be obtained during the minigame"
+// But there may be other pets available, so keeping a generic base rate
+var Pet = pets.Pet{Name: "Rift guardian", BaseRate: 5000}
+
 // Reward represents a GOTR reward item
 type Reward struct {
 	Name     string `json:"name"`
@@ -3,7 +3,11 @@ package gotr
 import (
 	"fmt"
 	"math"
-	"osrs-xp-kits/internal/calculators"
+	"osrs-xp-kits/internal/calculators/pets"
+	"osrs-xp-kits/internal/calculators/probability"
+	"osrs-xp-kits/internal/calculators/progression"
+	"osrs-xp-kits/internal/calculators/tools"
+	"osrs-xp-kits/internal/calculators/xp"
 )
 
 // GOTRResult represents the calculated results for GOTR training
@@ -20,6 +24,15 @@ type GOTRResult struct {
 	EstimatedRewards    []Reward `json:"estimated_rewards"`
 	TotalRewardValue    int      `json:"total_reward_value"`
 	GPPerHour           float64  `json:"gp_per_hour"`
+
+	ExpectedLoot tools.ExpectedLoot      `json:"expected_loot"`
+	Progression  progression.Progression `json:"progression"`
+	UniqueOdds   []probability.DropOdds  `json:"unique_odds"`
+	PetOdds      pets.Odds               `json:"pet_odds"`
+
+	// Seed drives the reward distribution so a shared result can be reproduced
+	Seed         int64               `json:"seed"`
+	Distribution *tools.Distribution `json:"distribution,omitempty"`
 }
 
 // CalculateGOTRData performs the main GOTR calculation
@@ -38,27 +51,38 @@ func CalculateGOTRData(currentLevel, targetLevel int) (GOTRResult, error) {
 	}
 
 	// Calculate XP needed
-	xpNeeded, err := calculators.XPRequired(currentLevel, targetLevel)
+	xpNeeded, err := xp.Required(currentLevel, targetLevel)
 	if err != nil {
 		return GOTRResult{}, fmt.Errorf("error calculating XP required: %v", err)
 	}
 
-	// Calculate average XP per game based on runecrafting level
-	// GOTR XP scales with RC level, with optimal rates around level 77+
-	avgXPPerGame := calculateXPPerGame(currentLevel, targetLevel)
-	avgXPPerHour := avgXPPerGame * GamesPerHour
+	// GOTR XP scales with RC level, with optimal rates around level 77+.
+	// Walk level by level so every game uses the rate of the level it is played at.
+	levelProgression, err := progression.WalkLevels(currentLevel, targetLevel, gameRate)
+	if err != nil {
+		return GOTRResult{}, fmt.Errorf("error calculating progression: %v", err)
+	}
+	avgXPPerGame := levelProgression.AverageXPPerAction()
+	avgXPPerHour := levelProgression.AverageXPPerHour
 
 	// Calculate games and time needed
-	gamesNeeded := int(math.Ceil(xpNeeded / avgXPPerGame))
+	gamesNeeded := int(math.Ceil(levelProgression.TotalActions))
 	hoursNeeded := float64(gamesNeeded) / GamesPerHour
 
 	// Calculate reward searches (approximately 18 searches per game on average)
 	totalSearches := int(float64(gamesNeeded) * AverageRewardSearches)
 
-	// Calculate pet chance (Note: Rift guardian cannot be obtained during minigame per wiki)
-	// Using generic pet rate for any other possible pets
-	petChance := 1.0 - math.Pow(1.0-PetRatePerSearch, float64(totalSearches))
-	petChancePercentage := petChance * 100
+	// Calculate pet chance, rolled on every reward search at the level the game is played at
+	petOdds, err := Pet.OverProgression(xp.ForLevel(currentLevel), levelProgression, AverageRewardSearches)
+	if err != nil {
+		return GOTRResult{}, fmt.Errorf("error calculating pet odds: %v", err)
+	}
+	petChancePercentage := petOdds.Chance * 100
+
+	uniqueOdds, err := CalculateUniqueOdds(totalSearches)
+	if err != nil {
+		return GOTRResult{}, fmt.Errorf("error calculating unique odds: %v", err)
+	}
 
 	// Simulate rewards
 	rewards, totalValue := SimulateAverageRewards(totalSearches)
@@ -67,24 +91,58 @@ func CalculateGOTRData(currentLevel, targetLevel int) (GOTRResult, error) {
 	return GOTRResult{
 		CurrentLevel:        currentLevel,
 		TargetLevel:         targetLevel,
-		XPNeeded:            int(xpNeeded),
+		XPNeeded:            xpNeeded,
 		GamesNeeded:         gamesNeeded,
 		HoursNeeded:         hoursNeeded,
 		AverageXPPerGame:    avgXPPerGame,
 		AverageXPPerHour:    avgXPPerHour,
 		TotalRewardRolls:    totalSearches,
 		PetChancePercentage: petChancePercentage,
+		PetOdds:             petOdds,
 		EstimatedRewards:    rewards,
 		TotalRewardValue:    totalValue,
 		GPPerHour:           gpPerHour,
+		ExpectedLoot:        ExpectedRewards(totalSearches),
+		Progression:         levelProgression,
+		UniqueOdds:          uniqueOdds,
 	}, nil
 }
 
-// calculateXPPerGame calculates the average XP per GOTR game based on RC level
+// trackedUniques are the reward table keys reported with drop odds, one roll per search
+var trackedUniques = []string{"abyssal_needle"}
+
+// CalculateUniqueOdds returns the odds of each tracked unique over the given reward searches
+func CalculateUniqueOdds(totalSearches int) ([]probability.DropOdds, error) {
+	chances := make(map[string]float64)
+	for _, sc := range searchChances(rewardTableWeight()) {
+		chances[sc.key] = sc.chance
+	}
+
+	uniques := make([]probability.Unique, 0, len(trackedUniques))
+	for _, key := range trackedUniques {
+		uniques = append(uniques, probability.Unique{Name: RewardTable[key].Name, Rate: chances[key]})
+	}
+	return probability.UniqueOdds(uniques, totalSearches)
+}
+
+// UniqueDropOdds returns the odds of the tracked uniques
+func (r GOTRResult) UniqueDropOdds() []probability.DropOdds {
+	return r.UniqueOdds
+}
+
+// gameRate returns the XP per game and games per hour at an RC level
+func gameRate(level int) progression.Rate {
+	return progression.Rate{
+		XPPerAction:    xpPerHourAtLevel(level) / GamesPerHour,
+		ActionsPerHour: GamesPerHour,
+		SuccessChance:  1,
+	}
+}
+
+// xpPerHourAtLevel returns the GOTR XP per hour at an RC level
 // Based on real player data: 20k-50k XP/hour depending on level
-func calculateXPPerGame(currentLevel, targetLevel int) float64 {
-	// Use the average level for calculation to account for leveling up during training
-	avgLevel := float64(currentLevel+targetLevel) / 2.0
+func xpPerHourAtLevel(level int) float64 {
+	lvl := float64(level)
 
 	// Calculate XP per hour based on realistic rates from player guides
 	var xpPerHour float64
@@ -94,26 +152,22 @@ func calculateXPPerGame(currentLevel, targetLevel int) float64 {
 	// Level 80: 45,000 XP/hr
 	// Level 90: 50,000 XP/hr
 
-	if avgLevel <= 27 {
+	if lvl <= 27 {
 		xpPerHour = 20000
-	} else if avgLevel <= 80 {
+	} else if lvl <= 80 {
 		// Linear interpolation between level 27 (20k) and level 80 (45k)
 		// Rate increases by 25k over 53 levels = ~471 XP/hr per level
-		xpPerHour = 20000 + (avgLevel-27)*471.7
-	} else if avgLevel <= 90 {
+		xpPerHour = 20000 + (lvl-27)*471.7
+	} else if lvl <= 90 {
 		// Linear interpolation between level 80 (45k) and level 90 (48k)
 		// Rate increases by 3k over 10 levels = 300 XP/hr per level
-		xpPerHour = 45000 + (avgLevel-80)*300
+		xpPerHour = 45000 + (lvl-80)*300
 	} else {
 		// Cap at level 90+ rates
 		xpPerHour = 48000
 	}
 
-	// Convert XP per hour to XP per game
-	// Using 6 games per hour (10 minute games)
-	xpPerGame := xpPerHour / GamesPerHour
-
-	return xpPerGame
+	return xpPerHour
 }
 
 // CalculateOptimalStrategy suggests the best approach based on current level
@@ -161,7 +215,7 @@ func GetCalculationProTips() map[string]any {
 	return map[string]any{
 		"calculation_methodology": map[string]any{
 			"xp_rates_source": "Based on real player data from community guides",
-			"base_formula":    "Linear interpolation between known XP/hour data points",
+			"base_formula":    "Linear interpolation between known XP/hour data points, applied level by level",
 			"data_points": []map[string]any{
 				{"level": 27, "xp_per_hour": 20000, "note": "Minimum access level"},
 				{"level": 50, "xp_per_hour": 30000, "note": "Mid-level efficiency"},
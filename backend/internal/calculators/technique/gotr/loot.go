@@ -4,8 +4,32 @@ import (
 	"math"
 	"math/rand"
 	"time"
+
+	"osrs-xp-kits/internal/calculators/tools"
+)
+
+// Chances used when rolling a single reward search
+const (
+	commonItemChance        = 0.8 // 80% chance for each common item
+	runeChanceMultiplier    = 2.5 // boost rune drop rates
+	rareChanceMultiplier    = 0.1 // very rare
+	specialChanceMultiplier = 1.2
 )
 
+// Reward table keys rolled on every search, in roll order
+var (
+	commonSearchItems  = []string{"guardian_essence", "catalytic_guardian_stone", "elemental_guardian_stone"}
+	runeSearchItems    = []string{"nature_rune", "death_rune", "blood_rune", "soul_rune"}
+	rareSearchItems    = []string{"abyssal_needle", "abyssal_lantern", "raiments_of_the_eye_top", "raiments_of_the_eye_bottom", "hat_of_the_eye"}
+	specialSearchItems = []string{"intrinsic_catalyst", "lantern_lens"}
+)
+
+// searchChance is the chance of one reward table key being rolled on a search
+type searchChance struct {
+	key    string
+	chance float64
+}
+
 // LootSimulator handles GOTR reward calculations
 type LootSimulator struct {
 	rand *rand.Rand
@@ -29,10 +53,7 @@ func (ls *LootSimulator) SimulateRewards(totalSearches int) ([]Reward, int) {
 	totalValue := 0
 
 	// Calculate total weight for drop rate calculations
-	totalWeight := 0
-	for _, item := range RewardTable {
-		totalWeight += item.Weight
-	}
+	totalWeight := rewardTableWeight()
 
 	// Simulate each search
 	for search := 0; search < totalSearches; search++ {
@@ -72,50 +93,87 @@ func (ls *LootSimulator) SimulateRewards(totalSearches int) ([]Reward, int) {
 func (ls *LootSimulator) getItemsForSearch(totalWeight int) []string {
 	items := make([]string, 0, 3) // typically 2-3 items per search
 
-	// Guaranteed common drops (essence and stones)
-	commonItems := []string{"guardian_essence", "catalytic_guardian_stone", "elemental_guardian_stone"}
-	for _, item := range commonItems {
-		if ls.rand.Float64() < 0.8 { // 80% chance for each common item
-			items = append(items, item)
+	// Every item is checked independently: common drops, runes, rares, then special items
+	for _, sc := range searchChances(totalWeight) {
+		if ls.rand.Float64() < sc.chance {
+			items = append(items, sc.key)
 		}
 	}
 
-	// Chance for rune rewards
-	runeItems := []string{"nature_rune", "death_rune", "blood_rune", "soul_rune"}
-	for _, item := range runeItems {
-		itemData := RewardTable[item]
-		chance := float64(itemData.Weight) / float64(totalWeight) * 2.5 // boost rune drop rates
-		if ls.rand.Float64() < chance {
-			items = append(items, item)
-		}
+	// Ensure at least one item is always dropped
+	if len(items) == 0 {
+		items = append(items, "guardian_essence")
 	}
 
-	// Rare drops (very low chance)
-	rareItems := []string{"abyssal_needle", "abyssal_lantern", "raiments_of_the_eye_top", "raiments_of_the_eye_bottom", "hat_of_the_eye"}
-	for _, item := range rareItems {
-		itemData := RewardTable[item]
-		chance := float64(itemData.Weight) / float64(totalWeight) * 0.1 // very rare
-		if ls.rand.Float64() < chance {
-			items = append(items, item)
-		}
+	return items
+}
+
+// searchChances returns the chance of every reward table key on a single search, in roll order
+func searchChances(totalWeight int) []searchChance {
+	chances := make([]searchChance, 0, len(commonSearchItems)+len(runeSearchItems)+len(rareSearchItems)+len(specialSearchItems))
+
+	// Guaranteed common drops (essence and stones)
+	for _, key := range commonSearchItems {
+		chances = append(chances, searchChance{key, commonItemChance})
 	}
 
-	// Special items
-	specialItems := []string{"intrinsic_catalyst", "lantern_lens"}
-	for _, item := range specialItems {
-		itemData := RewardTable[item]
-		chance := float64(itemData.Weight) / float64(totalWeight) * 1.2
-		if ls.rand.Float64() < chance {
-			items = append(items, item)
+	weighted := func(keys []string, multiplier float64) {
+		for _, key := range keys {
+			share := float64(RewardTable[key].Weight) / float64(totalWeight)
+			chances = append(chances, searchChance{key, share * multiplier})
 		}
 	}
+	weighted(runeSearchItems, runeChanceMultiplier)
+	weighted(rareSearchItems, rareChanceMultiplier)
+	weighted(specialSearchItems, specialChanceMultiplier)
 
-	// Ensure at least one item is always dropped
-	if len(items) == 0 {
-		items = append(items, "guardian_essence")
+	return chances
+}
+
+// rewardTableWeight sums the weights of every reward
+func rewardTableWeight() int {
+	totalWeight := 0
+	for _, item := range RewardTable {
+		totalWeight += item.Weight
+	}
+	return totalWeight
+}
+
+// ExpectedRewards returns the exact expected rewards from the given number of searches, without any RNG.
+// It uses the same per-search chances as LootSimulator. The fallback guardian essence given when a
+// search rolls nothing is added to the essence chance; its small correlation with the other
+// items is ignored in the value variance.
+func ExpectedRewards(totalSearches int) tools.ExpectedLoot {
+	chances := searchChances(rewardTableWeight())
+
+	nothingChance := 1.0
+	for _, sc := range chances {
+		nothingChance *= 1 - sc.chance
 	}
 
-	return items
+	table := make(tools.DropTable, 0, len(chances))
+	for _, sc := range chances {
+		item := RewardTable[sc.key]
+		probability := sc.chance
+		if sc.key == "guardian_essence" {
+			probability += nothingChance
+		}
+
+		minQuantity, maxQuantity := item.VarianceMin, item.VarianceMax
+		if minQuantity == maxQuantity {
+			minQuantity, maxQuantity = item.BaseQuantity, item.BaseQuantity
+		}
+
+		table = append(table, tools.DropItem{
+			Name:        item.Name,
+			Probability: probability,
+			Price:       item.Value,
+			MinQuantity: minQuantity,
+			MaxQuantity: maxQuantity,
+		})
+	}
+
+	return table.ExpectedIndependent(totalSearches)
 }
 
 // calculateQuantity determines the quantity of an item based on its variance
@@ -0,0 +1,36 @@
+package gotr
+
+import (
+	"context"
+
+	"osrs-xp-kits/internal/calculators/tools"
+)
+
+// uniqueCategories are the reward categories tracked individually in a distribution
+var uniqueCategories = map[string]bool{
+	"outfit": true,
+	"rare":   true,
+}
+
+// SimulateRewardsDistribution runs the reward search simulation for many independent trials and
+// summarises the total value and the count of every outfit piece and rare reward
+func SimulateRewardsDistribution(ctx context.Context, totalSearches int, trials int, seed int64) (tools.Distribution, error) {
+	rollsPerSearch := len(searchChances(rewardTableWeight()))
+	return tools.SimulateDistribution(ctx, trials, totalSearches*rollsPerSearch, seed, func(trialSeed int64) tools.Trial {
+		rewards, totalValue := NewLootSimulatorWithSeed(trialSeed).SimulateRewards(totalSearches)
+
+		items := make(map[string]int)
+		for _, item := range RewardTable {
+			if uniqueCategories[item.Category] {
+				items[item.Name] = 0
+			}
+		}
+		for _, reward := range rewards {
+			if _, tracked := items[reward.Name]; tracked {
+				items[reward.Name] = reward.Quantity
+			}
+		}
+
+		return tools.Trial{TotalValue: totalValue, Items: items}
+	})
+}
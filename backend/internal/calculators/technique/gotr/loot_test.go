@@ -1,6 +1,7 @@
 package gotr
 
 import (
+	"math"
 	"testing"
 )
 
@@ -77,3 +78,23 @@ func TestSimulateAverageRewardsDeterministic(t *testing.T) {
 		t.Errorf("Number of rewards not consistent: %d, %d, %d", len(rewards1), len(rewards2), len(rewards3))
 	}
 }
+
+// TestExpectedRewardsMatchesSimulation checks the closed-form rewards against a long seeded simulation
+func TestExpectedRewardsMatchesSimulation(t *testing.T) {
+	const searches = 20000
+
+	expected := ExpectedRewards(searches)
+	_, totalValue := NewLootSimulatorWithSeed(12345).SimulateRewards(searches)
+
+	if expected.TotalValue <= 0 {
+		t.Fatalf("Expected a positive total value, got %.0f", expected.TotalValue)
+	}
+	if diff := math.Abs(float64(totalValue) - expected.TotalValue); diff > 4*expected.ValueStdDev {
+		t.Errorf("Simulated value %d is too far from expected %.0f (std dev %.0f)", totalValue, expected.TotalValue, expected.ValueStdDev)
+	}
+
+	// Stable between calls
+	if again := ExpectedRewards(searches); again.TotalValue != expected.TotalValue {
+		t.Errorf("Expected rewards changed between calls: %.2f vs %.2f", expected.TotalValue, again.TotalValue)
+	}
+}
@@ -0,0 +1,77 @@
+package herbruns
+
+import (
+	"fmt"
+
+	"osrs-xp-kits/internal/calculators"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+// HerbRunInput is the request body accepted by the herb run calculator
+type HerbRunInput struct {
+	FarmingLevel int           `json:"farming_level"`
+	Herb         string        `json:"herb"`
+	Patches      []PatchChoice `json:"patches"`
+	Compost      string        `json:"compost"`
+	Anima        string        `json:"anima"`
+	Equipment    Equipment     `json:"equipment"`
+	RunsPerDay   int           `json:"runs_per_day,omitempty"`
+}
+
+// Calculator exposes herb runs through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the herb run calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "herb_runs",
+		Name:        "Herb Runs",
+		Description: "Expected herbs per patch, disease chance, Farming XP and profit per herb run",
+		Category:    "skilling",
+		Skills:      []string{"farming"},
+	}
+}
+
+// Validate checks the herb run input
+func (Calculator) Validate(input HerbRunInput) error {
+	if input.FarmingLevel < MinimumFarmingLevel {
+		return fmt.Errorf("farming level must be at least %d", MinimumFarmingLevel)
+	}
+	if input.FarmingLevel > xp.MaxLevel {
+		return fmt.Errorf("farming level must be at most %d", xp.MaxLevel)
+	}
+	if _, exists := Herbs[input.Herb]; !exists {
+		return fmt.Errorf("invalid herb: %s", input.Herb)
+	}
+	if _, exists := Composts[input.Compost]; !exists {
+		return fmt.Errorf("invalid compost: %s", input.Compost)
+	}
+	if len(input.Patches) == 0 {
+		return fmt.Errorf("at least one patch is required")
+	}
+	for _, patch := range input.Patches {
+		if _, exists := Patches[patch.ID]; !exists {
+			return fmt.Errorf("invalid patch: %s", patch.ID)
+		}
+	}
+	return nil
+}
+
+// Calculate runs the herb run calculation
+func (Calculator) Calculate(input HerbRunInput, opts calculators.Options) (HerbRunResult, error) {
+	return CalculateHerbRunDataWithPrices(
+		input.FarmingLevel,
+		input.Herb,
+		input.Patches,
+		input.Compost,
+		Anima(input.Anima),
+		input.Equipment,
+		input.RunsPerDay,
+		opts.Prices,
+	)
+}
+
+// ProTips returns the herb run calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
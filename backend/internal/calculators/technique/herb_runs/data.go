@@ -0,0 +1,107 @@
+package herbruns
+
+// Herb is a herb seed that can be planted in a herb patch
+type Herb struct {
+	Name      string
+	Seed      string // Seed item name, used for live prices
+	Grimy     string // Harvested item name, used for live prices
+	Level     int
+	PlantXP   float64
+	HarvestXP float64
+	// ChanceToSaveLow and ChanceToSaveHigh are the harvest-life save chances out of 256 at level 1 and 99
+	ChanceToSaveLow  int
+	ChanceToSaveHigh int
+	SeedValue        int // Default prices when live prices are unavailable
+	HerbValue        int
+}
+
+// Herbs keyed by the name used in requests
+var Herbs = map[string]Herb{
+	"guam":        {"Guam", "Guam seed", "Grimy guam leaf", 9, 11, 12.5, 25, 80, 10, 20},
+	"marrentill":  {"Marrentill", "Marrentill seed", "Grimy marrentill", 14, 13.5, 15, 28, 80, 10, 15},
+	"tarromin":    {"Tarromin", "Tarromin seed", "Grimy tarromin", 19, 16, 18, 31, 80, 15, 60},
+	"harralander": {"Harralander", "Harralander seed", "Grimy harralander", 26, 21.5, 24, 36, 80, 20, 300},
+	"ranarr":      {"Ranarr", "Ranarr seed", "Grimy ranarr weed", 32, 27, 30.5, 39, 80, 42000, 6500},
+	"toadflax":    {"Toadflax", "Toadflax seed", "Grimy toadflax", 38, 34, 38.5, 43, 80, 3000, 2500},
+	"irit":        {"Irit", "Irit seed", "Grimy irit leaf", 44, 43, 48.5, 46, 80, 50, 700},
+	"avantoe":     {"Avantoe", "Avantoe seed", "Grimy avantoe", 50, 54.5, 61.5, 50, 80, 2500, 2400},
+	"kwuarm":      {"Kwuarm", "Kwuarm seed", "Grimy kwuarm", 56, 69, 78, 54, 80, 3500, 2300},
+	"snapdragon":  {"Snapdragon", "Snapdragon seed", "Grimy snapdragon", 62, 87.5, 98.5, 57, 80, 45000, 7500},
+	"cadantine":   {"Cadantine", "Cadantine seed", "Grimy cadantine", 67, 106.5, 120, 60, 80, 500, 1200},
+	"lantadyme":   {"Lantadyme", "Lantadyme seed", "Grimy lantadyme", 73, 134.5, 151.5, 64, 80, 1000, 1600},
+	"dwarf_weed":  {"Dwarf weed", "Dwarf weed seed", "Grimy dwarf weed", 79, 170.5, 192, 67, 80, 1200, 1900},
+	"torstol":     {"Torstol", "Torstol seed", "Grimy torstol", 85, 199.5, 224.5, 71, 80, 55000, 9000},
+}
+
+// Patch is a herb patch. Diary bonuses only apply when the patch's diary is completed.
+type Patch struct {
+	Name          string
+	Level         int  // Farming level needed to use the patch
+	DiseaseFree   bool // Herbs here never become diseased
+	Diary         string
+	DiaryYield    float64 // Bonus chance to save a harvest life
+	DiaryXP       float64 // Bonus Farming XP on the patch
+	DiaryImmunity bool    // Diary makes the patch disease-free
+}
+
+// Patches keyed by the ID used in requests
+var Patches = map[string]Patch{
+	"falador":       {Name: "Falador", Diary: "Falador medium", DiaryXP: 0.10},
+	"catherby":      {Name: "Catherby", Diary: "Kandarin hard", DiaryYield: 0.10},
+	"ardougne":      {Name: "Ardougne"},
+	"morytania":     {Name: "Morytania"},
+	"hosidius":      {Name: "Hosidius", Diary: "Kourend & Kebos easy", DiaryImmunity: true},
+	"farming_guild": {Name: "Farming Guild", Level: 65},
+	"trollheim":     {Name: "Trollheim", DiseaseFree: true},
+	"weiss":         {Name: "Weiss", DiseaseFree: true},
+}
+
+// Compost is applied to every patch before planting
+type Compost struct {
+	Item          string // Item name, used for live prices
+	ExtraLives    int    // Harvest lives added to the base lives
+	DiseaseChance float64
+	XP            float64 // Farming XP for applying the compost
+	Value         int
+}
+
+// Composts keyed by the name used in requests
+var Composts = map[string]Compost{
+	"none":         {DiseaseChance: 0.10},
+	"compost":      {Item: "Compost", ExtraLives: 1, DiseaseChance: 0.07, XP: 18, Value: 100},
+	"supercompost": {Item: "Supercompost", ExtraLives: 2, DiseaseChance: 0.05, XP: 26, Value: 150},
+	"ultracompost": {Item: "Ultracompost", ExtraLives: 3, DiseaseChance: 0.035, XP: 36, Value: 300},
+}
+
+// Anima is the plant growing in the anima patch during the run
+type Anima string
+
+const (
+	AnimaNone  Anima = "none"
+	AnimaAttas Anima = "attas"
+	AnimaIasor Anima = "iasor"
+)
+
+// Herb run constants based on OSRS Wiki
+const (
+	MinimumFarmingLevel = 9
+
+	// BaseHarvestLives is the harvest lives of a herb patch before compost
+	BaseHarvestLives = 3
+
+	// DiseaseStages is the number of growth stages at which a herb can catch disease
+	DiseaseStages = 3
+
+	// Bonus chance to save a harvest life from equipment and the Attas plant
+	MagicSecateursBonus = 0.10
+	FarmingCapeBonus    = 0.05
+	AttasBonus          = 0.05
+
+	// IasorDiseaseReduction is the share of disease chance removed by the Iasor plant
+	IasorDiseaseReduction = 0.8
+
+	// Herbs take 80 minutes to grow, so at most 18 runs fit in a day
+	GrowthMinutes     = 80
+	MaxRunsPerDay     = 18
+	DefaultRunsPerDay = 4
+)
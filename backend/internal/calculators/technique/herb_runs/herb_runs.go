@@ -0,0 +1,278 @@
+package herbruns
+
+import (
+	"fmt"
+	"math"
+
+	"osrs-xp-kits/internal/calculators/tools"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+// PatchChoice is a patch included in the run and whether its diary is completed
+type PatchChoice struct {
+	ID    string `json:"id"`
+	Diary bool   `json:"diary"`
+}
+
+// Equipment holds the items that raise the chance to save harvest lives
+type Equipment struct {
+	MagicSecateurs bool `json:"magic_secateurs"`
+	FarmingCape    bool `json:"farming_cape"`
+}
+
+type HerbRunResult struct {
+	FarmingLevel int           `json:"farming_level"`
+	Herb         string        `json:"herb"`
+	Compost      string        `json:"compost"`
+	Anima        string        `json:"anima"`
+	Patches      []PatchResult `json:"patches"`
+
+	ExpectedHerbs float64 `json:"expected_herbs"`
+	XPPerRun      float64 `json:"xp_per_run"`
+	SeedPrice     int     `json:"seed_price"`
+	CompostPrice  int     `json:"compost_price"`
+	HerbPrice     int     `json:"herb_price"`
+	CostPerRun    int     `json:"cost_per_run"`
+	ValuePerRun   int     `json:"value_per_run"`
+	ProfitPerRun  int     `json:"profit_per_run"`
+
+	RunsPerDay   int     `json:"runs_per_day"`
+	XPPerDay     float64 `json:"xp_per_day"`
+	ProfitPerDay int     `json:"profit_per_day"`
+}
+
+// PatchResult is the expected outcome of one patch per run
+type PatchResult struct {
+	ID             string  `json:"id"`
+	Name           string  `json:"name"`
+	DiaryApplied   bool    `json:"diary_applied"`
+	ChanceToSave   float64 `json:"chance_to_save"`
+	HarvestLives   int     `json:"harvest_lives"`
+	HealthyYield   float64 `json:"healthy_yield"` // Expected herbs when the patch survives
+	DiseaseChance  float64 `json:"disease_chance"`
+	ExpectedHerbs  float64 `json:"expected_herbs"`
+	XP             float64 `json:"xp"`
+	ExpectedProfit int     `json:"expected_profit"`
+}
+
+func CalculateHerbRunData(farmingLevel int, herbName string, patches []PatchChoice, compostName string, anima Anima, equipment Equipment, runsPerDay int) (HerbRunResult, error) {
+	return CalculateHerbRunDataWithPrices(farmingLevel, herbName, patches, compostName, anima, equipment, runsPerDay, nil)
+}
+
+// CalculateHerbRunDataWithPrices calculates herb run data with optional live seed, compost and herb prices
+func CalculateHerbRunDataWithPrices(farmingLevel int, herbName string, patches []PatchChoice, compostName string, anima Anima, equipment Equipment, runsPerDay int, livePrices map[string]int) (HerbRunResult, error) {
+	if farmingLevel < MinimumFarmingLevel {
+		return HerbRunResult{}, fmt.Errorf("farming level must be at least %d", MinimumFarmingLevel)
+	}
+
+	if farmingLevel > xp.MaxLevel {
+		return HerbRunResult{}, fmt.Errorf("farming level must be at most %d", xp.MaxLevel)
+	}
+
+	herb, exists := Herbs[herbName]
+	if !exists {
+		return HerbRunResult{}, fmt.Errorf("invalid herb: %s", herbName)
+	}
+	if farmingLevel < herb.Level {
+		return HerbRunResult{}, fmt.Errorf("%s requires %d Farming", herb.Name, herb.Level)
+	}
+
+	compost, exists := Composts[compostName]
+	if !exists {
+		return HerbRunResult{}, fmt.Errorf("invalid compost: %s", compostName)
+	}
+
+	if anima == "" {
+		anima = AnimaNone
+	}
+	if anima != AnimaNone && anima != AnimaAttas && anima != AnimaIasor {
+		return HerbRunResult{}, fmt.Errorf("invalid anima plant: %s", anima)
+	}
+
+	if len(patches) == 0 {
+		return HerbRunResult{}, fmt.Errorf("at least one patch is required")
+	}
+
+	if runsPerDay == 0 {
+		runsPerDay = DefaultRunsPerDay
+	}
+	if runsPerDay < 1 || runsPerDay > MaxRunsPerDay {
+		return HerbRunResult{}, fmt.Errorf("runs per day must be between 1 and %d", MaxRunsPerDay)
+	}
+
+	seedPrice := tools.PriceOr(herb.Seed, herb.SeedValue, livePrices)
+	herbPrice := tools.PriceOr(herb.Grimy, herb.HerbValue, livePrices)
+	compostPrice := 0
+	if compost.Item != "" {
+		compostPrice = tools.PriceOr(compost.Item, compost.Value, livePrices)
+	}
+
+	itemBonus := 0.0
+	if equipment.MagicSecateurs {
+		itemBonus += MagicSecateursBonus
+	}
+	if equipment.FarmingCape {
+		itemBonus += FarmingCapeBonus
+	}
+	animaBonus := 0.0
+	if anima == AnimaAttas {
+		animaBonus = AttasBonus
+	}
+
+	lives := BaseHarvestLives + compost.ExtraLives
+	seen := make(map[string]bool, len(patches))
+	results := make([]PatchResult, 0, len(patches))
+	totalHerbs, totalXP := 0.0, 0.0
+
+	for _, choice := range patches {
+		patch, exists := Patches[choice.ID]
+		if !exists {
+			return HerbRunResult{}, fmt.Errorf("invalid patch: %s", choice.ID)
+		}
+		if seen[choice.ID] {
+			return HerbRunResult{}, fmt.Errorf("patch %s listed more than once", choice.ID)
+		}
+		seen[choice.ID] = true
+		if farmingLevel < patch.Level {
+			return HerbRunResult{}, fmt.Errorf("the %s patch requires %d Farming", patch.Name, patch.Level)
+		}
+
+		diaryApplied := choice.Diary && patch.Diary != ""
+		diaryYield, diaryXP := 0.0, 0.0
+		if diaryApplied {
+			diaryYield, diaryXP = patch.DiaryYield, patch.DiaryXP
+		}
+
+		saveChance := ChanceToSave(herb, farmingLevel, itemBonus, diaryYield, animaBonus)
+		healthyYield := ExpectedYield(lives, saveChance)
+
+		diseaseChance := 0.0
+		if !patch.DiseaseFree && !(diaryApplied && patch.DiaryImmunity) {
+			diseaseChance = DiseaseChance(compost, anima)
+		}
+
+		expectedHerbs := healthyYield * (1 - diseaseChance)
+		patchXP := (herb.PlantXP + compost.XP + expectedHerbs*herb.HarvestXP) * (1 + diaryXP)
+		profit := expectedHerbs*float64(herbPrice) - float64(seedPrice+compostPrice)
+
+		results = append(results, PatchResult{
+			ID:             choice.ID,
+			Name:           patch.Name,
+			DiaryApplied:   diaryApplied,
+			ChanceToSave:   saveChance,
+			HarvestLives:   lives,
+			HealthyYield:   healthyYield,
+			DiseaseChance:  diseaseChance,
+			ExpectedHerbs:  expectedHerbs,
+			XP:             patchXP,
+			ExpectedProfit: int(math.Round(profit)),
+		})
+		totalHerbs += expectedHerbs
+		totalXP += patchXP
+	}
+
+	costPerRun := (seedPrice + compostPrice) * len(patches)
+	valuePerRun := int(math.Round(totalHerbs * float64(herbPrice)))
+	profitPerRun := valuePerRun - costPerRun
+
+	return HerbRunResult{
+		FarmingLevel:  farmingLevel,
+		Herb:          herb.Name,
+		Compost:       compostName,
+		Anima:         string(anima),
+		Patches:       results,
+		ExpectedHerbs: totalHerbs,
+		XPPerRun:      totalXP,
+		SeedPrice:     seedPrice,
+		CompostPrice:  compostPrice,
+		HerbPrice:     herbPrice,
+		CostPerRun:    costPerRun,
+		ValuePerRun:   valuePerRun,
+		ProfitPerRun:  profitPerRun,
+		RunsPerDay:    runsPerDay,
+		XPPerDay:      totalXP * float64(runsPerDay),
+		ProfitPerDay:  profitPerRun * runsPerDay,
+	}, nil
+}
+
+// ChanceToSave returns the chance that a harvest does not use up a harvest life.
+// The base chance interpolates between the herb's level 1 and level 99 values out of 256,
+// then item, diary and Attas bonuses multiply it.
+func ChanceToSave(herb Herb, level int, itemBonus, diaryBonus, animaBonus float64) float64 {
+	level = min(level, xp.MaxLevel)
+	base := math.Floor(float64(herb.ChanceToSaveLow*(99-level))/98) +
+		math.Floor(float64(herb.ChanceToSaveHigh*(level-1))/98)
+	bonused := math.Floor(base * (1 + itemBonus) * (1 + diaryBonus) * (1 + animaBonus))
+	return (bonused + 1) / 256
+}
+
+// ExpectedYield returns the expected herbs from a patch with the given harvest lives.
+// Every harvest uses a life unless saved, so the harvests follow a negative binomial distribution.
+func ExpectedYield(lives int, saveChance float64) float64 {
+	return float64(lives) / (1 - saveChance)
+}
+
+// DiseaseChance returns the chance that a herb dies before it is fully grown
+func DiseaseChance(compost Compost, anima Anima) float64 {
+	perStage := compost.DiseaseChance
+	if anima == AnimaIasor {
+		perStage *= 1 - IasorDiseaseReduction
+	}
+	return 1 - math.Pow(1-perStage, DiseaseStages)
+}
+
+// GetCalculationProTips provides detailed information about how herb run calculations work
+func GetCalculationProTips() map[string]any {
+	return map[string]any{
+		"calculation_methodology": map[string]any{
+			"xp_rates_source": "Harvest-life formula and herb data from the OSRS Wiki",
+			"base_formula":    "Expected herbs = harvest lives ÷ (1 − chance to save), times the chance the patch survives disease",
+			"data_points": []map[string]any{
+				{"herb": "ranarr", "level": 32, "compost": "ultracompost", "healthy_yield": 7.5, "note": "No secateurs"},
+				{"herb": "ranarr", "level": 99, "compost": "ultracompost", "healthy_yield": 8.8, "note": "No secateurs"},
+				{"herb": "torstol", "level": 99, "compost": "ultracompost", "healthy_yield": 9.4, "note": "Magic secateurs and farming cape"},
+			},
+		},
+		"game_mechanics": map[string]any{
+			"harvest_lives":  "Herb patches start with 3 harvest lives, plus 1, 2 or 3 from compost, supercompost or ultracompost",
+			"chance_to_save": "Each harvest has a chance to keep its life, rising with Farming level, magic secateurs, the farming cape, diaries and Attas",
+			"disease":        "Herbs can catch disease at each growth stage except the first; compost and Iasor lower the chance",
+			"growth_time":    "Herbs take 80 minutes to grow",
+		},
+		"factors_considered": []string{
+			"Farming level (affects the chance to save harvest lives)",
+			"Compost type (affects harvest lives and disease)",
+			"Magic secateurs and farming cape",
+			"Diary bonuses and disease-free patches",
+			"Attas or Iasor in the anima patch",
+		},
+		"accuracy_notes": map[string]any{
+			"rates_vary": "Individual harvests vary widely between runs:",
+			"variance_factors": []string{
+				"Harvests follow a negative binomial distribution",
+				"Disease is all or nothing for each patch",
+				"Seed and herb prices change quickly",
+			},
+			"calculation_basis": "Results are per-run averages over many runs",
+		},
+		"pro_tips": []map[string]string{
+			{
+				"tip":         "Ultracompost Pays Off",
+				"description": "An extra harvest life and lower disease chance is worth far more than the compost on valuable herbs",
+			},
+			{
+				"tip":         "Disease-Free Patches",
+				"description": "Trollheim, Weiss and Hosidius with the Kourend & Kebos easy diary never lose a herb to disease",
+			},
+			{
+				"tip":         "Magic Secateurs",
+				"description": "Magic secateurs raise the chance to save a harvest life by 10% and work on every herb patch",
+			},
+		},
+		"reward_calculation": map[string]any{
+			"cost_per_run":   "Seed and compost price for every patch",
+			"value_per_run":  "Expected herbs × grimy herb price",
+			"profit_per_day": "Profit per run × runs per day",
+		},
+	}
+}
@@ -0,0 +1,157 @@
+package herbruns
+
+import (
+	"math"
+	"testing"
+)
+
+var testPatches = []PatchChoice{
+	{ID: "falador", Diary: true},
+	{ID: "catherby", Diary: true},
+	{ID: "ardougne"},
+	{ID: "morytania"},
+	{ID: "hosidius", Diary: true},
+	{ID: "trollheim"},
+}
+
+func TestCalculateHerbRunData(t *testing.T) {
+	tests := []struct {
+		name         string
+		farmingLevel int
+		herb         string
+		patches      []PatchChoice
+		compost      string
+		expectError  bool
+	}{
+		{
+			name:         "Ranarr at 32 with ultracompost",
+			farmingLevel: 32,
+			herb:         "ranarr",
+			patches:      testPatches,
+			compost:      "ultracompost",
+		},
+		{
+			name:         "Torstol at 99 without compost",
+			farmingLevel: 99,
+			herb:         "torstol",
+			patches:      testPatches,
+			compost:      "none",
+		},
+		{
+			name:         "Herb level too high",
+			farmingLevel: 50,
+			herb:         "snapdragon",
+			patches:      testPatches,
+			compost:      "compost",
+			expectError:  true,
+		},
+		{
+			name:         "Farming Guild below 65",
+			farmingLevel: 60,
+			herb:         "ranarr",
+			patches:      []PatchChoice{{ID: "farming_guild"}},
+			compost:      "compost",
+			expectError:  true,
+		},
+		{
+			name:         "Invalid patch",
+			farmingLevel: 60,
+			herb:         "ranarr",
+			patches:      []PatchChoice{{ID: "lumbridge"}},
+			compost:      "compost",
+			expectError:  true,
+		},
+		{
+			name:         "Invalid compost",
+			farmingLevel: 60,
+			herb:         "ranarr",
+			patches:      testPatches,
+			compost:      "bottomless",
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculateHerbRunData(tt.farmingLevel, tt.herb, tt.patches, tt.compost, AnimaNone, Equipment{}, 0)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(result.Patches) != len(tt.patches) {
+				t.Errorf("Expected %d patch results, got %d", len(tt.patches), len(result.Patches))
+			}
+			if result.ExpectedHerbs <= 0 || result.XPPerRun <= 0 {
+				t.Errorf("Herbs and XP should be positive: %.2f, %.0f", result.ExpectedHerbs, result.XPPerRun)
+			}
+			if result.ProfitPerRun != result.ValuePerRun-result.CostPerRun {
+				t.Errorf("Profit should be value minus cost")
+			}
+			if result.ProfitPerDay != result.ProfitPerRun*DefaultRunsPerDay {
+				t.Errorf("Profit per day should use %d runs", DefaultRunsPerDay)
+			}
+		})
+	}
+}
+
+func TestChanceToSaveRisesWithLevelAndBonuses(t *testing.T) {
+	ranarr := Herbs["ranarr"]
+
+	low := ChanceToSave(ranarr, 32, 0, 0, 0)
+	high := ChanceToSave(ranarr, 99, 0, 0, 0)
+	boosted := ChanceToSave(ranarr, 99, MagicSecateursBonus+FarmingCapeBonus, 0.10, AttasBonus)
+
+	if !(low < high && high < boosted) {
+		t.Errorf("Chance to save should rise with level and bonuses: %.4f, %.4f, %.4f", low, high, boosted)
+	}
+	if want := (80.0 + 1) / 256; math.Abs(high-want) > 1e-9 {
+		t.Errorf("Level 99 chance = %.4f, want %.4f", high, want)
+	}
+}
+
+func TestDiseaseFreePatches(t *testing.T) {
+	result, err := CalculateHerbRunData(70, "ranarr", testPatches, "supercompost", AnimaNone, Equipment{}, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, patch := range result.Patches {
+		diseaseFree := patch.ID == "trollheim" || patch.ID == "hosidius"
+		if diseaseFree && patch.DiseaseChance != 0 {
+			t.Errorf("%s should be disease-free, got %.3f", patch.Name, patch.DiseaseChance)
+		}
+		if !diseaseFree && patch.DiseaseChance <= 0 {
+			t.Errorf("%s should have a disease chance", patch.Name)
+		}
+	}
+}
+
+func TestIasorLowersDisease(t *testing.T) {
+	compost := Composts["compost"]
+	if DiseaseChance(compost, AnimaIasor) >= DiseaseChance(compost, AnimaNone) {
+		t.Errorf("Iasor should lower the disease chance")
+	}
+}
+
+func TestLivePricesOverrideDefaults(t *testing.T) {
+	prices := map[string]int{"Ranarr seed": 1000, "Grimy ranarr weed": 10000, "Ultracompost": 0}
+	result, err := CalculateHerbRunDataWithPrices(80, "ranarr", testPatches, "ultracompost", AnimaNone, Equipment{}, 1, prices)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result.CostPerRun != 1000*len(testPatches) {
+		t.Errorf("Cost per run should use live seed prices, got %d", result.CostPerRun)
+	}
+	if result.HerbPrice != 10000 {
+		t.Errorf("Herb price should be the live price, got %d", result.HerbPrice)
+	}
+}
@@ -0,0 +1,54 @@
+package herbiboar
+
+import (
+	"fmt"
+
+	"osrs-xp-kits/internal/calculators"
+)
+
+// Calculator exposes Herbiboar hunting through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the Herbiboar calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "herbiboar",
+		Name:        "Herbiboar",
+		Description: "Hunter and Herblore XP, herb profit and Herbi pet odds from Herbiboar tracking",
+		Category:    "skilling",
+		Skills:      []string{"hunter", "herblore"},
+	}
+}
+
+// Validate checks the Herbiboar input
+func (Calculator) Validate(input HerbiboarInput) error {
+	if input.HunterLevel < 80 {
+		return fmt.Errorf("hunter level must be at least 80, got %d", input.HunterLevel)
+	}
+	if input.HerbloreLevel < 31 {
+		return fmt.Errorf("herblore level must be at least 31, got %d", input.HerbloreLevel)
+	}
+	switch input.CalculationType {
+	case "target":
+		if input.TargetLevel == nil {
+			return fmt.Errorf("target level is required for target calculation type")
+		}
+	case "number":
+		if input.NumberToCatch == nil {
+			return fmt.Errorf("number to catch is required for number calculation type")
+		}
+	default:
+		return fmt.Errorf("invalid calculation type: %s", input.CalculationType)
+	}
+	return nil
+}
+
+// Calculate runs the Herbiboar calculation
+func (Calculator) Calculate(input HerbiboarInput, opts calculators.Options) (HerbiboarResult, error) {
+	return CalculateHerbiboarDataWithPrices(input, opts.Prices)
+}
+
+// ProTips returns the Herbiboar calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
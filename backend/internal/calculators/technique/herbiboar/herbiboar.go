@@ -3,6 +3,11 @@ package herbiboar
 import (
 	"fmt"
 	"math"
+
+	"osrs-xp-kits/internal/calculators/pets"
+	"osrs-xp-kits/internal/calculators/probability"
+	"osrs-xp-kits/internal/calculators/progression"
+	"osrs-xp-kits/internal/calculators/xp"
 )
 
 type HerbiboarResult struct {
@@ -19,6 +24,13 @@ type HerbiboarResult struct {
 	CumulativePetOdds float64                `json:"cumulative_pet_odds"`
 	MagicSecateurs    bool                   `json:"magic_secateurs_used"`
 	GearEffects       map[string]interface{} `json:"gear_effects"`
+	UniqueOdds        []probability.DropOdds `json:"unique_odds"`
+	PetOdds           pets.Odds              `json:"pet_odds"`
+}
+
+// UniqueDropOdds returns the odds of getting Herbi
+func (r HerbiboarResult) UniqueDropOdds() []probability.DropOdds {
+	return r.UniqueOdds
 }
 
 type HerbiboarInput struct {
@@ -72,8 +84,8 @@ var herbloreXP = map[string]struct {
 	"Grimy torstol":     {cleaning: 15, potion: 155},     // Super combat
 }
 
-// Pet chance: 1/6500 per herbiboar
-const petRate = 6500.0
+// Herbi is rolled once per herbiboar, with better odds at higher Hunter levels
+var Herbi = pets.Pet{Name: "Herbi", BaseRate: 6500}
 
 func CalculateHerbiboarData(input HerbiboarInput) (HerbiboarResult, error) {
 	return CalculateHerbiboarDataWithPrices(input, nil)
@@ -93,6 +105,10 @@ func CalculateHerbiboarDataWithPrices(input HerbiboarInput, livePrices map[strin
 
 	var herbiboarsCaught int
 	var timeRequired float64
+	var totalHunterXP int
+	var petOdds pets.Odds
+
+	currentXP := xp.ForLevel(input.HunterLevel)
 
 	// Calculate based on type
 	switch input.CalculationType {
@@ -100,24 +116,38 @@ func CalculateHerbiboarDataWithPrices(input HerbiboarInput, livePrices map[strin
 		if input.TargetLevel == nil {
 			return HerbiboarResult{}, fmt.Errorf("target level is required for target calculation type")
 		}
-		xpNeeded := calculateXPNeeded(input.HunterLevel, *input.TargetLevel)
-		xpPerHerbiboar := calculateHunterXP(input.HunterLevel)
-		herbiboarsCaught = int(math.Ceil(float64(xpNeeded) / float64(xpPerHerbiboar)))
-		timeRequired = float64(herbiboarsCaught) / float64(herbiboarsPerHour)
+		targetXP := xp.ForLevel(min(*input.TargetLevel, xp.MaxVirtualLevel))
+
+		// Walk level by level so every catch uses the XP and pet chance of the level it is made at
+		levelProgression, err := progression.Walk(currentXP, max(targetXP, currentXP), herbiboarRate)
+		if err != nil {
+			return HerbiboarResult{}, err
+		}
+		herbiboarsCaught = int(math.Ceil(levelProgression.TotalActions))
+		timeRequired = levelProgression.TotalHours
+		totalHunterXP = int(math.Round(levelProgression.AverageXPPerAction() * float64(herbiboarsCaught)))
+
+		petOdds, err = Herbi.OverProgression(currentXP, levelProgression, 1)
+		if err != nil {
+			return HerbiboarResult{}, err
+		}
 	case "number":
 		if input.NumberToCatch == nil {
 			return HerbiboarResult{}, fmt.Errorf("number to catch is required for number calculation type")
 		}
 		herbiboarsCaught = *input.NumberToCatch
 		timeRequired = float64(herbiboarsCaught) / float64(herbiboarsPerHour)
+		totalHunterXP = herbiboarsCaught * calculateHunterXP(input.HunterLevel)
+
+		var err error
+		petOdds, err = Herbi.AtXP(currentXP, float64(herbiboarsCaught))
+		if err != nil {
+			return HerbiboarResult{}, err
+		}
 	default:
 		return HerbiboarResult{}, fmt.Errorf("invalid calculation type: %s", input.CalculationType)
 	}
 
-	// Calculate XP
-	hunterXPPerBoar := calculateHunterXP(input.HunterLevel)
-	totalHunterXP := herbiboarsCaught * hunterXPPerBoar
-
 	// Calculate herbs obtained and herblore XP
 	herbsPerBoar := 2
 	if input.MagicSecateurs {
@@ -150,10 +180,14 @@ func CalculateHerbiboarDataWithPrices(input HerbiboarInput, livePrices map[strin
 	}
 
 	// Calculate pet chance
-	individualPetChance := 1.0 / petRate
-	cumulativePetOdds := 1.0 - math.Pow(1.0-individualPetChance, float64(herbiboarsCaught))
+	cumulativePetOdds := petOdds.Chance
 	petChancePercent := cumulativePetOdds * 100
 
+	uniqueOdds, err := probability.UniqueOdds([]probability.Unique{{Name: Herbi.Name, Rate: petOdds.EffectiveRate}}, herbiboarsCaught)
+	if err != nil {
+		return HerbiboarResult{}, err
+	}
+
 	// Calculate profit per hour
 	profitPerHour := 0
 	if timeRequired > 0 {
@@ -188,6 +222,8 @@ func CalculateHerbiboarDataWithPrices(input HerbiboarInput, livePrices map[strin
 		CumulativePetOdds: cumulativePetOdds,
 		MagicSecateurs:    input.MagicSecateurs,
 		GearEffects:       gearEffects,
+		UniqueOdds:        uniqueOdds,
+		PetOdds:           petOdds,
 	}, nil
 }
 
@@ -228,30 +264,13 @@ func calculateHunterXP(hunterLevel int) int {
 	}
 }
 
-func calculateXPNeeded(currentLevel, targetLevel int) int {
-	// XP table for levels (simplified)
-	xpTable := []int{
-		0, 0, 83, 174, 276, 388, 512, 650, 801, 969, 1154, 1358, 1584, 1833, 2107, 2411,
-		2746, 3115, 3523, 3973, 4470, 5018, 5624, 6291, 7028, 7842, 8740, 9730, 10824, 12031,
-		13363, 14833, 16456, 18247, 20224, 22406, 24815, 27473, 30408, 33648, 37224, 41171,
-		45529, 50339, 55649, 61512, 67983, 75127, 83014, 91721, 101333, 111945, 123660, 136594,
-		150872, 166636, 184040, 203254, 224466, 247886, 273742, 302288, 333804, 368599, 407015,
-		449428, 496254, 547953, 605032, 668051, 737627, 814445, 899257, 992895, 1096278, 1210421,
-		1336443, 1475581, 1629200, 1798808, 1986068, 2192818, 2421087, 2673114, 2951373, 3258594,
-		3597792, 3972294, 4385776, 4842295, 5346332, 5902831, 6517253, 7195629, 7944614, 8771558,
-		9684577, 10692629, 11805606, 13034431, 14391160, 15889109, 17542976, 19368992, 21385073,
-		23611006, 26068632, 28782069, 31777943, 35085654, 38737661, 42769801, 47221641, 52136869,
-		57563718, 63555443, 70170840, 77474828, 85539082, 94442737, 104273167,
+// herbiboarRate returns the catches per hour and Hunter XP per catch at a level
+func herbiboarRate(level int) progression.Rate {
+	return progression.Rate{
+		XPPerAction:    float64(calculateHunterXP(level)),
+		ActionsPerHour: float64(calculateHerbiboarsPerHour(level)),
+		SuccessChance:  1,
 	}
-
-	if targetLevel > len(xpTable)-1 {
-		targetLevel = len(xpTable) - 1
-	}
-	if currentLevel >= len(xpTable)-1 {
-		return 0
-	}
-
-	return xpTable[targetLevel] - xpTable[currentLevel]
 }
 
 func GetCalculationProTips() map[string]interface{} {
@@ -261,7 +280,7 @@ func GetCalculationProTips() map[string]interface{} {
 		"key_mechanics": []string{
 			"Requires 80 Hunter and 31 Herblore minimum",
 			"Magic secateurs increase herb yield from 2 to 3 per herbiboar",
-			"Pet chance is 1/6500 per herbiboar caught",
+			"Pet chance is 1/(6500 - 25 x Hunter level) per herbiboar caught, 15x at 200M XP",
 			"Herbs obtained can be cleaned and made into potions for Herblore XP",
 		},
 		"calculation_methodology": map[string]interface{}{
@@ -288,7 +307,7 @@ func GetCalculationProTips() map[string]interface{} {
 			"Magic Secateurs bonus herb calculation",
 			"Herblore level-dependent herb drop probabilities",
 			"Cleaning XP + most common potion XP per herb type",
-			"Pet drop rate of exactly 1/6,500 per catch",
+			"Level-scaled pet rate from a 1/6,500 base per catch",
 			"Live OSRS Wiki API prices when available",
 		},
 		"optimization_tips": []map[string]string{
@@ -306,7 +325,7 @@ func GetCalculationProTips() map[string]interface{} {
 			},
 			{
 				"tip":         "Pet Hunting",
-				"description": "Herbi pet has 1/6500 drop rate - patience required for collection",
+				"description": "Herbi pet has a 1/6500 base rate that improves with Hunter level - patience required for collection",
 			},
 		},
 		"limitations": []string{
@@ -0,0 +1,75 @@
+package herblore
+
+import (
+	"fmt"
+
+	"osrs-xp-kits/internal/calculators"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+// HerbloreInput is the request body accepted by the Herblore calculator
+type HerbloreInput struct {
+	CurrentLevel   int     `json:"current_level"`
+	TargetLevel    int     `json:"target_level"`
+	Recipe         string  `json:"recipe"`
+	FromUnfinished bool    `json:"from_unfinished"` // Buy unfinished potions instead of herbs and vials
+	Amulet         string  `json:"amulet,omitempty"`
+	SecondarySaver string  `json:"secondary_saver,omitempty"`
+	HerbloreCape   bool    `json:"herblore_cape,omitempty"`
+	PotionsPerHour float64 `json:"potions_per_hour,omitempty"` // 0 uses the default rate
+}
+
+// Calculator exposes Herblore through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the Herblore calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "herblore",
+		Name:        "Herblore",
+		Description: "Potions needed, ingredient cost, output value, XP/hour and profit per XP for potion making",
+		Category:    "skilling",
+		Skills:      []string{"herblore"},
+	}
+}
+
+// Validate checks the Herblore input
+func (Calculator) Validate(input HerbloreInput) error {
+	book, err := LoadRecipes()
+	if err != nil {
+		return err
+	}
+	recipe, exists := book.Find(input.Recipe)
+	if !exists {
+		return fmt.Errorf("invalid recipe: %s", input.Recipe)
+	}
+	if input.CurrentLevel < recipe.Level {
+		return fmt.Errorf("%s requires %d Herblore", recipe.Name, recipe.Level)
+	}
+	if input.TargetLevel <= input.CurrentLevel {
+		return fmt.Errorf("target level must be greater than current level")
+	}
+	if input.TargetLevel > xp.MaxVirtualLevel {
+		return fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+	if _, exists := Amulets[orNone(input.Amulet)]; !exists {
+		return fmt.Errorf("invalid amulet: %s", input.Amulet)
+	}
+	if _, exists := SecondarySavers[orNone(input.SecondarySaver)]; !exists {
+		return fmt.Errorf("invalid secondary saver: %s", input.SecondarySaver)
+	}
+	if input.PotionsPerHour < 0 || input.PotionsPerHour > MaxPotionsPerHour {
+		return fmt.Errorf("potions per hour must be between 1 and %d", MaxPotionsPerHour)
+	}
+	return nil
+}
+
+// Calculate runs the Herblore calculation
+func (Calculator) Calculate(input HerbloreInput, opts calculators.Options) (HerbloreResult, error) {
+	return CalculateHerbloreDataWithPrices(input, opts.Prices)
+}
+
+// ProTips returns the Herblore calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
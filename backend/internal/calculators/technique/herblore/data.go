@@ -0,0 +1,94 @@
+package herblore
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+//go:embed recipes.json
+var recipeFile []byte
+
+// Recipe is a potion made from an unfinished potion and a secondary ingredient
+type Recipe struct {
+	ID                string  `json:"id"`
+	Name              string  `json:"name"`
+	Level             int     `json:"levelReq"`
+	XP                float64 `json:"xp"` // XP for adding the secondary
+	Herb              string  `json:"herb"`
+	Unfinished        string  `json:"unfinished"`
+	Secondary         string  `json:"secondary"`
+	SecondaryQuantity int     `json:"secondaryQuantity"`
+	Vial              string  `json:"vial"`
+	Potion            string  `json:"potion"`         // The 3-dose potion normally made
+	FourDosePotion    string  `json:"fourDosePotion"` // Made instead when a chemistry effect procs
+}
+
+// RecipeBook is the parsed recipe file
+type RecipeBook struct {
+	Recipes []Recipe `json:"recipes"`
+	// DefaultPrices are used for items without a live price
+	DefaultPrices map[string]int `json:"defaultPrices"`
+}
+
+// Find returns the recipe with the given ID
+func (b RecipeBook) Find(id string) (Recipe, bool) {
+	index := slices.IndexFunc(b.Recipes, func(r Recipe) bool { return r.ID == id })
+	if index < 0 {
+		return Recipe{}, false
+	}
+	return b.Recipes[index], true
+}
+
+// LoadRecipes returns the embedded recipe book, ordered by level
+var LoadRecipes = sync.OnceValues(func() (RecipeBook, error) {
+	var book RecipeBook
+	if err := json.Unmarshal(recipeFile, &book); err != nil {
+		return RecipeBook{}, fmt.Errorf("failed to parse herblore recipes: %w", err)
+	}
+
+	for _, recipe := range book.Recipes {
+		if recipe.XP <= 0 || recipe.SecondaryQuantity < 1 {
+			return RecipeBook{}, fmt.Errorf("herblore recipe %s is missing XP or secondary quantity", recipe.ID)
+		}
+	}
+
+	slices.SortStableFunc(book.Recipes, func(a, b Recipe) int { return a.Level - b.Level })
+	return book, nil
+})
+
+// Amulet is an amulet with a chance to make a 4-dose potion instead of a 3-dose one
+type Amulet struct {
+	Item           string  // Item name, used for live prices
+	FourDoseChance float64 // Chance per potion to make a 4-dose potion
+	Charges        int     // 4-dose potions made before the amulet crumbles, 0 when it never does
+}
+
+// Amulets keyed by the name used in requests
+var Amulets = map[string]Amulet{
+	"none":                {},
+	"amulet_of_chemistry": {Item: "Amulet of chemistry", FourDoseChance: 0.05, Charges: 5},
+	"botanists_amulet":    {Item: "Botanist's amulet", FourDoseChance: 0.05},
+}
+
+// SecondarySavers keyed by the name used in requests, with the chance to keep the secondary
+var SecondarySavers = map[string]float64{
+	"none":         0,
+	"goading":      0.05,
+	"prescription": 0.10,
+}
+
+// Herblore constants based on OSRS Wiki
+const (
+	// HerbloreCapeSaveChance is the Herblore cape's chance to keep the secondary,
+	// rolled after any other secondary saving effect
+	HerbloreCapeSaveChance = 0.05
+
+	// Potions per hour when adding secondaries to unfinished potions,
+	// and when making the unfinished potions first
+	DefaultPotionsPerHour          = 2500
+	DefaultPotionsPerHourFromHerbs = 1300
+	MaxPotionsPerHour              = 5000
+)
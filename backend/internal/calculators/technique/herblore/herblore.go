@@ -0,0 +1,221 @@
+package herblore
+
+import (
+	"fmt"
+	"math"
+
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+type HerbloreResult struct {
+	Recipe          string       `json:"recipe"`
+	CurrentLevel    int          `json:"current_level"`
+	TargetLevel     int          `json:"target_level"`
+	XPNeeded        int          `json:"xp_needed"`
+	FromUnfinished  bool         `json:"from_unfinished"`
+	PotionsNeeded   int          `json:"potions_needed"`
+	FourDosePotions float64      `json:"four_dose_potions"` // Expected 4-dose potions from the amulet
+	Ingredients     []Ingredient `json:"ingredients"`
+	IngredientCost  int          `json:"ingredient_cost"`
+	OutputValue     int          `json:"output_value"`
+	Profit          int          `json:"profit"` // Negative when the potions sell for less than they cost
+	ProfitPerPotion float64      `json:"profit_per_potion"`
+	ProfitPerXP     float64      `json:"profit_per_xp"`
+	PotionsPerHour  float64      `json:"potions_per_hour"`
+	XPPerHour       float64      `json:"xp_per_hour"`
+	Hours           float64      `json:"hours"`
+}
+
+// Ingredient is an item used up while making the potions
+type Ingredient struct {
+	Item     string  `json:"item"`
+	Quantity float64 `json:"quantity"`
+	Price    int     `json:"price"`
+	Cost     int     `json:"cost"`
+}
+
+func CalculateHerbloreData(input HerbloreInput) (HerbloreResult, error) {
+	return CalculateHerbloreDataWithPrices(input, nil)
+}
+
+// CalculateHerbloreDataWithPrices calculates potion making with optional live ingredient and potion prices
+func CalculateHerbloreDataWithPrices(input HerbloreInput, livePrices map[string]int) (HerbloreResult, error) {
+	book, err := LoadRecipes()
+	if err != nil {
+		return HerbloreResult{}, err
+	}
+
+	recipe, exists := book.Find(input.Recipe)
+	if !exists {
+		return HerbloreResult{}, fmt.Errorf("invalid recipe: %s", input.Recipe)
+	}
+
+	if input.CurrentLevel < recipe.Level {
+		return HerbloreResult{}, fmt.Errorf("%s requires %d Herblore", recipe.Name, recipe.Level)
+	}
+
+	if input.TargetLevel <= input.CurrentLevel {
+		return HerbloreResult{}, fmt.Errorf("target level must be greater than current level")
+	}
+
+	if input.TargetLevel > xp.MaxVirtualLevel {
+		return HerbloreResult{}, fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+
+	amulet, exists := Amulets[orNone(input.Amulet)]
+	if !exists {
+		return HerbloreResult{}, fmt.Errorf("invalid amulet: %s", input.Amulet)
+	}
+
+	secondarySave, exists := SecondarySavers[orNone(input.SecondarySaver)]
+	if !exists {
+		return HerbloreResult{}, fmt.Errorf("invalid secondary saver: %s", input.SecondarySaver)
+	}
+	if input.HerbloreCape {
+		secondarySave = 1 - (1-secondarySave)*(1-HerbloreCapeSaveChance)
+	}
+
+	potionsPerHour := input.PotionsPerHour
+	if potionsPerHour == 0 {
+		potionsPerHour = DefaultPotionsPerHourFromHerbs
+		if input.FromUnfinished {
+			potionsPerHour = DefaultPotionsPerHour
+		}
+	}
+	if potionsPerHour < 0 || potionsPerHour > MaxPotionsPerHour {
+		return HerbloreResult{}, fmt.Errorf("potions per hour must be between 1 and %d", MaxPotionsPerHour)
+	}
+
+	price := func(item string) int {
+		if livePrice, exists := livePrices[item]; exists {
+			return livePrice
+		}
+		return book.DefaultPrices[item]
+	}
+
+	xpNeeded := xp.ForLevel(input.TargetLevel) - xp.ForLevel(input.CurrentLevel)
+	potions := int(math.Ceil(float64(xpNeeded) / recipe.XP))
+	fourDose := float64(potions) * amulet.FourDoseChance
+
+	quantities := []usage{
+		{recipe.Secondary, float64(potions*recipe.SecondaryQuantity) * (1 - secondarySave)},
+	}
+	if input.FromUnfinished {
+		quantities = append(quantities, usage{recipe.Unfinished, float64(potions)})
+	} else {
+		quantities = append(quantities, usage{recipe.Herb, float64(potions)}, usage{recipe.Vial, float64(potions)})
+	}
+	if amulet.Charges > 0 {
+		quantities = append(quantities, usage{amulet.Item, fourDose / float64(amulet.Charges)})
+	}
+
+	var ingredients []Ingredient
+	ingredientCost := 0
+	for _, q := range quantities {
+		itemPrice := price(q.item)
+		cost := int(math.Round(q.quantity * float64(itemPrice)))
+		ingredients = append(ingredients, Ingredient{
+			Item:     q.item,
+			Quantity: q.quantity,
+			Price:    itemPrice,
+			Cost:     cost,
+		})
+		ingredientCost += cost
+	}
+
+	outputValue := int(math.Round((float64(potions)-fourDose)*float64(price(recipe.Potion)) + fourDose*float64(price(recipe.FourDosePotion))))
+	profit := outputValue - ingredientCost
+	totalXP := float64(potions) * recipe.XP
+
+	return HerbloreResult{
+		Recipe:          recipe.Name,
+		CurrentLevel:    input.CurrentLevel,
+		TargetLevel:     input.TargetLevel,
+		XPNeeded:        xpNeeded,
+		FromUnfinished:  input.FromUnfinished,
+		PotionsNeeded:   potions,
+		FourDosePotions: fourDose,
+		Ingredients:     ingredients,
+		IngredientCost:  ingredientCost,
+		OutputValue:     outputValue,
+		Profit:          profit,
+		ProfitPerPotion: float64(profit) / float64(potions),
+		ProfitPerXP:     float64(profit) / totalXP,
+		PotionsPerHour:  potionsPerHour,
+		XPPerHour:       recipe.XP * potionsPerHour,
+		Hours:           float64(potions) / potionsPerHour,
+	}, nil
+}
+
+// usage is how many of an item the potions use up
+type usage struct {
+	item     string
+	quantity float64
+}
+
+// orNone treats an empty option as "none"
+func orNone(option string) string {
+	if option == "" {
+		return "none"
+	}
+	return option
+}
+
+// GetCalculationProTips provides detailed information about how Herblore calculations work
+func GetCalculationProTips() map[string]any {
+	return map[string]any{
+		"calculation_methodology": map[string]any{
+			"xp_rates_source": "Potion XP and ingredients from the OSRS Wiki, loaded from the recipe file",
+			"base_formula":    "Potions needed = XP needed ÷ potion XP; XP/hour = potion XP × potions per hour",
+			"data_points": []map[string]any{
+				{"recipe": "prayer_potion", "from_unfinished": true, "xp_per_hour": 218750},
+				{"recipe": "super_restore", "from_unfinished": true, "xp_per_hour": 356250},
+				{"recipe": "saradomin_brew", "from_unfinished": true, "xp_per_hour": 450000},
+			},
+		},
+		"game_mechanics": map[string]any{
+			"potion_making": "Herblore XP is given when the secondary is added to an unfinished potion",
+			"four_dose":     "The amulet of chemistry and Botanist's amulet give a 5% chance to make a 4-dose potion",
+			"secondary_saving": []string{
+				"Goading saves the secondary 5% of the time",
+				"Prescription saves the secondary 10% of the time",
+				"The Herblore cape saves the secondary 5% of the time, rolled after the other effects",
+			},
+		},
+		"factors_considered": []string{
+			"Recipe and Herblore level",
+			"Buying unfinished potions or making them from clean herbs and vials",
+			"Amulet and secondary saving effects",
+			"Ingredient and potion prices",
+			"Potions per hour",
+		},
+		"accuracy_notes": map[string]any{
+			"rates_vary": "Profit depends heavily on prices:",
+			"variance_factors": []string{
+				"Herb, secondary and potion prices change daily",
+				"Grand Exchange tax on the potions sold is not included",
+				"Potions per hour depend on banking and clicking speed",
+			},
+			"calculation_basis": "Expected values for the amulet and secondary saving effects",
+		},
+		"pro_tips": []map[string]string{
+			{
+				"tip":         "Buy Unfinished Potions",
+				"description": "Unfinished potions cost a little more than herbs and vials but nearly double the potions per hour",
+			},
+			{
+				"tip":         "Wear an Amulet",
+				"description": "4-dose potions sell for a third more, which often pays for the amulet of chemistry",
+			},
+			{
+				"tip":         "Check the Secondaries",
+				"description": "Expensive secondaries like crushed nests and wine of zamorak decide whether a potion makes a profit",
+			},
+		},
+		"reward_calculation": map[string]any{
+			"ingredient_cost": "Unfinished potions (or herbs and vials) + secondaries after saving + amulets used",
+			"output_value":    "3-dose potions × 3-dose price + 4-dose potions × 4-dose price",
+			"profit_per_xp":   "(Output value - ingredient cost) ÷ XP gained",
+		},
+	}
+}
@@ -0,0 +1,137 @@
+package herblore
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLoadRecipes(t *testing.T) {
+	book, err := LoadRecipes()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(book.Recipes) == 0 {
+		t.Fatal("Expected recipes in the recipe file")
+	}
+	for _, recipe := range book.Recipes {
+		for _, item := range []string{recipe.Herb, recipe.Unfinished, recipe.Secondary, recipe.Vial, recipe.Potion, recipe.FourDosePotion} {
+			if _, exists := book.DefaultPrices[item]; !exists {
+				t.Errorf("%s: no default price for %s", recipe.ID, item)
+			}
+		}
+	}
+}
+
+func TestCalculateHerbloreData(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       HerbloreInput
+		expectError bool
+	}{
+		{
+			name:  "Prayer potions from unfinished",
+			input: HerbloreInput{CurrentLevel: 38, TargetLevel: 63, Recipe: "prayer_potion", FromUnfinished: true},
+		},
+		{
+			name: "Saradomin brews with every bonus",
+			input: HerbloreInput{
+				CurrentLevel: 81, TargetLevel: 99, Recipe: "saradomin_brew",
+				Amulet: "amulet_of_chemistry", SecondarySaver: "prescription", HerbloreCape: true,
+			},
+		},
+		{
+			name:        "Level too low for the recipe",
+			input:       HerbloreInput{CurrentLevel: 50, TargetLevel: 70, Recipe: "super_restore"},
+			expectError: true,
+		},
+		{
+			name:        "Invalid recipe",
+			input:       HerbloreInput{CurrentLevel: 50, TargetLevel: 70, Recipe: "overload"},
+			expectError: true,
+		},
+		{
+			name:        "Invalid amulet",
+			input:       HerbloreInput{CurrentLevel: 50, TargetLevel: 70, Recipe: "prayer_potion", Amulet: "amulet_of_glory"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculateHerbloreData(tt.input)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result.PotionsNeeded <= 0 || result.Hours <= 0 {
+				t.Errorf("Expected positive potions and hours, got %d and %f", result.PotionsNeeded, result.Hours)
+			}
+			if result.Profit != result.OutputValue-result.IngredientCost {
+				t.Errorf("Expected profit %d, got %d", result.OutputValue-result.IngredientCost, result.Profit)
+			}
+		})
+	}
+}
+
+func TestSecondarySaving(t *testing.T) {
+	base := HerbloreInput{CurrentLevel: 81, TargetLevel: 90, Recipe: "saradomin_brew", FromUnfinished: true}
+	saving := base
+	saving.SecondarySaver = "prescription"
+	saving.HerbloreCape = true
+
+	secondaries := func(input HerbloreInput) float64 {
+		result, err := CalculateHerbloreData(input)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result.Ingredients[0].Quantity
+	}
+
+	expected := secondaries(base) * 0.9 * 0.95
+	if got := secondaries(saving); math.Abs(got-expected) > 1e-6 {
+		t.Errorf("Expected %f secondaries, got %f", expected, got)
+	}
+}
+
+func TestAmuletOfChemistry(t *testing.T) {
+	input := HerbloreInput{CurrentLevel: 38, TargetLevel: 60, Recipe: "prayer_potion", FromUnfinished: true, Amulet: "amulet_of_chemistry"}
+	result, err := CalculateHerbloreData(input)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if expected := float64(result.PotionsNeeded) * 0.05; math.Abs(result.FourDosePotions-expected) > 1e-9 {
+		t.Errorf("Expected %f 4-dose potions, got %f", expected, result.FourDosePotions)
+	}
+
+	amulets := result.Ingredients[len(result.Ingredients)-1]
+	if amulets.Item != "Amulet of chemistry" || math.Abs(amulets.Quantity-result.FourDosePotions/5) > 1e-9 {
+		t.Errorf("Expected %f amulets of chemistry, got %f %s", result.FourDosePotions/5, amulets.Quantity, amulets.Item)
+	}
+}
+
+func TestCalculateHerbloreDataWithPrices(t *testing.T) {
+	input := HerbloreInput{CurrentLevel: 38, TargetLevel: 50, Recipe: "prayer_potion", FromUnfinished: true, PotionsPerHour: 2000}
+	livePrices := map[string]int{"Ranarr potion (unf)": 7000, "Snape grass": 100, "Prayer potion(3)": 7500}
+
+	result, err := CalculateHerbloreDataWithPrices(input, livePrices)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if expected := result.PotionsNeeded * (7500 - 7000 - 100); result.Profit != expected {
+		t.Errorf("Expected profit %d, got %d", expected, result.Profit)
+	}
+	if result.XPPerHour != 87.5*2000 {
+		t.Errorf("Expected %f XP per hour, got %f", 87.5*2000, result.XPPerHour)
+	}
+}
@@ -0,0 +1,48 @@
+package mahoganyhomes
+
+import (
+	"osrs-xp-kits/internal/calculators"
+)
+
+// MahoganyHomesInput is the request body accepted by the Mahogany Homes calculator.
+// At least one of Tier and Plank must be set; each tier is built with one plank type.
+type MahoganyHomesInput struct {
+	CurrentLevel     int      `json:"current_level"`
+	TargetLevel      int      `json:"target_level"`
+	Tier             string   `json:"tier,omitempty"`  // beginner, novice, adept or expert
+	Plank            string   `json:"plank,omitempty"` // e.g. "Teak plank"
+	PlankSack        bool     `json:"plank_sack,omitempty"`
+	Outfit           []string `json:"outfit,omitempty"`             // Carpenter's outfit pieces worn, e.g. ["helmet", "boots"]
+	ContractsPerHour float64  `json:"contracts_per_hour,omitempty"` // 0 uses the tier's default pace
+	StartingPoints   int      `json:"starting_points,omitempty"`
+}
+
+// Calculator exposes Mahogany Homes through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the Mahogany Homes calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "mahogany_homes",
+		Name:        "Mahogany Homes",
+		Description: "Construction XP and plank costs per contract, carpenter points toward the outfit and plank sack, and GP/XP against oak larders and mahogany tables",
+		Category:    "minigame",
+		Skills:      []string{"construction"},
+	}
+}
+
+// Validate checks the Mahogany Homes input
+func (Calculator) Validate(input MahoganyHomesInput) error {
+	_, err := validateInput(input)
+	return err
+}
+
+// Calculate runs the Mahogany Homes calculation
+func (Calculator) Calculate(input MahoganyHomesInput, opts calculators.Options) (MahoganyHomesResult, error) {
+	return CalculateMahoganyHomesDataWithPrices(input, opts.Prices)
+}
+
+// ProTips returns the Mahogany Homes calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
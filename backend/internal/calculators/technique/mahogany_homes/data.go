@@ -0,0 +1,135 @@
+package mahoganyhomes
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"sync"
+
+	"osrs-xp-kits/internal/calculators/skills"
+)
+
+// Tier is a Mahogany Homes contract tier. Each tier is built with one plank type.
+type Tier struct {
+	Name  string
+	Level int
+	Plank string // Plank item name, used for live prices
+	// PlankXP is the Construction XP per plank used on a contract
+	PlankXP float64
+	// PlanksPerContract and SteelBarsPerContract are averages over the tier's houses
+	PlanksPerContract    float64
+	SteelBarsPerContract float64
+	Points               int // Carpenter points per contract
+	// ContractsPerHour is the pace without a plank sack
+	ContractsPerHour float64
+	PlankValue       int // Default plank price when live prices are unavailable
+}
+
+// Tiers keyed by the name used in requests
+var Tiers = map[string]Tier{
+	"beginner": {"Beginner", 1, "Plank", 40, 10, 0.5, 2, 25, 300},
+	"novice":   {"Novice", 20, "Oak plank", 72, 12, 1, 3, 23, 600},
+	"adept":    {"Adept", 50, "Teak plank", 100, 14, 1, 4, 22, 1100},
+	"expert":   {"Expert", 70, "Mahogany plank", 168, 20, 1, 5, 22, 2000},
+}
+
+// OutfitPieces are the carpenter's outfit pieces keyed by the name used in requests,
+// with the Construction XP bonus each one gives
+var OutfitPieces = map[string]float64{
+	"helmet":   0.004,
+	"shirt":    0.008,
+	"trousers": 0.006,
+	"boots":    0.002,
+}
+
+// CarpenterReward is an item bought from Amy with carpenter points
+type CarpenterReward struct {
+	Name  string `json:"name"`
+	Piece string `json:"piece,omitempty"` // Outfit piece name, empty for other rewards
+	Cost  int    `json:"cost"`            // Carpenter points
+}
+
+// CarpenterRewards in the order they are usually bought, the plank sack first
+var CarpenterRewards = []CarpenterReward{
+	{"Plank sack", "", 350},
+	{"Carpenter's helmet", "helmet", 400},
+	{"Carpenter's boots", "boots", 200},
+	{"Carpenter's trousers", "trousers", 600},
+	{"Carpenter's shirt", "shirt", 800},
+}
+
+// ComparisonMethod is a POH training method from the construction skill data priced against contracts
+type ComparisonMethod struct {
+	ID          string // Training method ID in the construction skill data
+	Name        string
+	Level       int
+	XPPerAction float64
+	XPPerHour   float64
+	Plank       string
+	Planks      int // Planks per action
+	PlankValue  int
+}
+
+// comparisonMethodIDs are the construction training methods compared against contracts
+var comparisonMethodIDs = []string{"oak_larders", "mahogany_tables"}
+
+// plankItemPattern matches a required plank item such as "Oak plank (8)"
+var plankItemPattern = regexp.MustCompile(`^(.*[Pp]lank) \((\d+)\)$`)
+
+// ComparisonMethods returns the compared methods, read from the construction skill data.
+// The plank and planks per action come from each method's required items.
+var ComparisonMethods = sync.OnceValues(func() ([]ComparisonMethod, error) {
+	construction, err := skills.Load("construction")
+	if err != nil {
+		return nil, err
+	}
+
+	methods := make([]ComparisonMethod, 0, len(comparisonMethodIDs))
+	for _, id := range comparisonMethodIDs {
+		index := slices.IndexFunc(construction.TrainingMethods, func(m skills.TrainingMethod) bool { return m.ID == id })
+		if index < 0 {
+			return nil, fmt.Errorf("construction method %s not found", id)
+		}
+		training := construction.TrainingMethods[index]
+		if training.XPPerAction == nil || *training.XPPerAction <= 0 {
+			return nil, fmt.Errorf("construction method %s is missing XP per action", id)
+		}
+
+		method := ComparisonMethod{
+			ID:          training.ID,
+			Name:        training.Name,
+			Level:       training.LevelReq,
+			XPPerAction: *training.XPPerAction,
+			XPPerHour:   training.XPRate,
+		}
+		for _, item := range training.ItemsRequired {
+			if match := plankItemPattern.FindStringSubmatch(item); match != nil {
+				method.Plank = match[1]
+				method.Planks, _ = strconv.Atoi(match[2])
+			}
+		}
+		for _, tier := range Tiers {
+			if tier.Plank == method.Plank {
+				method.PlankValue = tier.PlankValue
+			}
+		}
+		if method.Planks == 0 || method.PlankValue == 0 {
+			return nil, fmt.Errorf("construction method %s has no known plank", id)
+		}
+		methods = append(methods, method)
+	}
+	return methods, nil
+})
+
+// Mahogany Homes constants based on OSRS Wiki
+const (
+	// FullOutfitBonus replaces the sum of the pieces when all four are worn
+	FullOutfitBonus = 0.025
+
+	// PlankSackPaceMultiplier is the extra contracts per hour from fewer bank trips
+	PlankSackPaceMultiplier = 1.25
+	MaxContractsPerHour     = 40
+
+	SteelBarValue = 400
+)
@@ -0,0 +1,324 @@
+package mahoganyhomes
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"osrs-xp-kits/internal/calculators/progression"
+	"osrs-xp-kits/internal/calculators/tools"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+type MahoganyHomesResult struct {
+	Tier         string `json:"tier"`
+	Plank        string `json:"plank"`
+	CurrentLevel int    `json:"current_level"`
+	TargetLevel  int    `json:"target_level"`
+	XPNeeded     int    `json:"xp_needed"`
+
+	OutfitBonus          float64 `json:"outfit_bonus"`
+	XPPerContract        float64 `json:"xp_per_contract"`
+	PlanksPerContract    float64 `json:"planks_per_contract"`
+	SteelBarsPerContract float64 `json:"steel_bars_per_contract"`
+	ContractsPerHour     float64 `json:"contracts_per_hour"`
+	XPPerHour            float64 `json:"xp_per_hour"`
+
+	PlankPrice      int     `json:"plank_price"`
+	SteelBarPrice   int     `json:"steel_bar_price"`
+	CostPerContract float64 `json:"cost_per_contract"`
+	CostPerHour     int     `json:"cost_per_hour"`
+	GPPerXP         float64 `json:"gp_per_xp"`
+
+	ContractsNeeded int     `json:"contracts_needed"`
+	PlanksNeeded    int     `json:"planks_needed"`
+	SteelBarsNeeded int     `json:"steel_bars_needed"`
+	HoursNeeded     float64 `json:"hours_needed"`
+	TotalCost       int     `json:"total_cost"`
+
+	PointsPerContract int              `json:"points_per_contract"`
+	PointsPerHour     float64          `json:"points_per_hour"`
+	TotalPoints       int              `json:"total_points"`
+	Rewards           []RewardProgress `json:"rewards"`
+
+	Comparisons []MethodComparison      `json:"comparisons"`
+	Progression progression.Progression `json:"progression"`
+}
+
+// RewardProgress tracks when a reward can be bought, buying the rewards not yet owned in order
+type RewardProgress struct {
+	CarpenterReward
+	CumulativeCost    int     `json:"cumulative_cost"`
+	ContractsToAfford int     `json:"contracts_to_afford"`
+	HoursToAfford     float64 `json:"hours_to_afford"`
+	Affordable        bool    `json:"affordable"`
+}
+
+// MethodComparison prices a POH method over the same XP as the contracts
+type MethodComparison struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Level       int     `json:"level"`
+	Available   bool    `json:"available"` // Whether the current level can build it
+	XPPerAction float64 `json:"xp_per_action"`
+	XPPerHour   float64 `json:"xp_per_hour"`
+	Plank       string  `json:"plank"`
+	PlankPrice  int     `json:"plank_price"`
+	GPPerXP     float64 `json:"gp_per_xp"`
+	HoursNeeded float64 `json:"hours_needed"`
+	TotalCost   int     `json:"total_cost"`
+}
+
+func CalculateMahoganyHomesData(input MahoganyHomesInput) (MahoganyHomesResult, error) {
+	return CalculateMahoganyHomesDataWithPrices(input, nil)
+}
+
+// CalculateMahoganyHomesDataWithPrices calculates Mahogany Homes data with optional live plank and steel bar prices
+func CalculateMahoganyHomesDataWithPrices(input MahoganyHomesInput, livePrices map[string]int) (MahoganyHomesResult, error) {
+	tier, err := validateInput(input)
+	if err != nil {
+		return MahoganyHomesResult{}, err
+	}
+
+	outfitBonus := OutfitBonus(input.Outfit)
+	xpPerContract := tier.PlankXP * tier.PlanksPerContract * (1 + outfitBonus)
+
+	contractsPerHour := input.ContractsPerHour
+	if contractsPerHour == 0 {
+		contractsPerHour = tier.ContractsPerHour
+		if input.PlankSack {
+			contractsPerHour *= PlankSackPaceMultiplier
+		}
+	}
+
+	plankPrice := tools.PriceOr(tier.Plank, tier.PlankValue, livePrices)
+	steelBarPrice := tools.PriceOr("Steel bar", SteelBarValue, livePrices)
+	costPerContract := tier.PlanksPerContract*float64(plankPrice) + tier.SteelBarsPerContract*float64(steelBarPrice)
+
+	currentXP := xp.ForLevel(input.CurrentLevel)
+	targetXP := xp.ForLevel(input.TargetLevel)
+	levelProgression, err := progression.Walk(currentXP, targetXP, func(level int) progression.Rate {
+		return progression.Rate{
+			XPPerAction:    xpPerContract,
+			ActionsPerHour: contractsPerHour,
+			SuccessChance:  1,
+		}
+	})
+	if err != nil {
+		return MahoganyHomesResult{}, err
+	}
+
+	contracts := int(math.Ceil(levelProgression.TotalActions))
+	points := tier.Points*contracts + input.StartingPoints
+
+	comparisonMethods, err := ComparisonMethods()
+	if err != nil {
+		return MahoganyHomesResult{}, err
+	}
+	comparisons := make([]MethodComparison, 0, len(comparisonMethods))
+	for _, method := range comparisonMethods {
+		// The outfit bonus applies in the house too
+		price := tools.PriceOr(method.Plank, method.PlankValue, livePrices)
+		xpPerAction := method.XPPerAction * (1 + outfitBonus)
+		xpPerHour := method.XPPerHour * (1 + outfitBonus)
+		gpPerXP := float64(method.Planks*price) / xpPerAction
+		xpNeeded := float64(targetXP - currentXP)
+		comparisons = append(comparisons, MethodComparison{
+			ID:          method.ID,
+			Name:        method.Name,
+			Level:       method.Level,
+			Available:   input.CurrentLevel >= method.Level,
+			XPPerAction: xpPerAction,
+			XPPerHour:   xpPerHour,
+			Plank:       method.Plank,
+			PlankPrice:  price,
+			GPPerXP:     gpPerXP,
+			HoursNeeded: xpNeeded / xpPerHour,
+			TotalCost:   int(math.Round(gpPerXP * xpNeeded)),
+		})
+	}
+
+	return MahoganyHomesResult{
+		Tier:                 tier.Name,
+		Plank:                tier.Plank,
+		CurrentLevel:         input.CurrentLevel,
+		TargetLevel:          input.TargetLevel,
+		XPNeeded:             targetXP - currentXP,
+		OutfitBonus:          outfitBonus,
+		XPPerContract:        xpPerContract,
+		PlanksPerContract:    tier.PlanksPerContract,
+		SteelBarsPerContract: tier.SteelBarsPerContract,
+		ContractsPerHour:     contractsPerHour,
+		XPPerHour:            xpPerContract * contractsPerHour,
+		PlankPrice:           plankPrice,
+		SteelBarPrice:        steelBarPrice,
+		CostPerContract:      costPerContract,
+		CostPerHour:          int(math.Round(costPerContract * contractsPerHour)),
+		GPPerXP:              costPerContract / xpPerContract,
+		ContractsNeeded:      contracts,
+		PlanksNeeded:         int(math.Ceil(tier.PlanksPerContract * float64(contracts))),
+		SteelBarsNeeded:      int(math.Ceil(tier.SteelBarsPerContract * float64(contracts))),
+		HoursNeeded:          levelProgression.TotalHours,
+		TotalCost:            int(math.Round(costPerContract * float64(contracts))),
+		PointsPerContract:    tier.Points,
+		PointsPerHour:        float64(tier.Points) * contractsPerHour,
+		TotalPoints:          points,
+		Rewards:              rewardProgress(input, points, tier.Points, contractsPerHour),
+		Comparisons:          comparisons,
+		Progression:          levelProgression,
+	}, nil
+}
+
+// OutfitBonus returns the Construction XP bonus of the carpenter's outfit pieces worn
+func OutfitBonus(pieces []string) float64 {
+	if len(pieces) == len(OutfitPieces) {
+		return FullOutfitBonus
+	}
+	bonus := 0.0
+	for _, piece := range pieces {
+		bonus += OutfitPieces[piece]
+	}
+	return bonus
+}
+
+// validateInput checks the input and returns the contract tier.
+// The tier can be given directly or through its plank type.
+func validateInput(input MahoganyHomesInput) (Tier, error) {
+	var tier Tier
+	switch {
+	case input.Tier != "":
+		var exists bool
+		tier, exists = Tiers[input.Tier]
+		if !exists {
+			return Tier{}, fmt.Errorf("invalid contract tier: %s", input.Tier)
+		}
+		if input.Plank != "" && input.Plank != tier.Plank {
+			return Tier{}, fmt.Errorf("%s contracts are built with %s, not %s", tier.Name, tier.Plank, input.Plank)
+		}
+	case input.Plank != "":
+		found := false
+		for _, candidate := range Tiers {
+			if candidate.Plank == input.Plank {
+				tier, found = candidate, true
+			}
+		}
+		if !found {
+			return Tier{}, fmt.Errorf("invalid plank type: %s", input.Plank)
+		}
+	default:
+		return Tier{}, fmt.Errorf("either tier or plank must be provided")
+	}
+
+	if err := xp.ValidateLevel(input.CurrentLevel); err != nil {
+		return Tier{}, fmt.Errorf("invalid current construction level: %w", err)
+	}
+	if input.CurrentLevel < tier.Level {
+		return Tier{}, fmt.Errorf("%s contracts require %d Construction", tier.Name, tier.Level)
+	}
+	if input.TargetLevel < input.CurrentLevel {
+		return Tier{}, fmt.Errorf("target level must be greater than or equal to current level")
+	}
+	if input.TargetLevel > xp.MaxVirtualLevel {
+		return Tier{}, fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+
+	for i, piece := range input.Outfit {
+		if _, exists := OutfitPieces[piece]; !exists {
+			return Tier{}, fmt.Errorf("invalid outfit piece: %s", piece)
+		}
+		if slices.Contains(input.Outfit[:i], piece) {
+			return Tier{}, fmt.Errorf("duplicate outfit piece: %s", piece)
+		}
+	}
+
+	if input.ContractsPerHour < 0 || input.ContractsPerHour > MaxContractsPerHour {
+		return Tier{}, fmt.Errorf("contracts per hour must be between 1 and %d", MaxContractsPerHour)
+	}
+	if input.StartingPoints < 0 {
+		return Tier{}, fmt.Errorf("starting points must not be negative")
+	}
+	return tier, nil
+}
+
+// rewardProgress buys the carpenter rewards not yet owned in order, starting from the points already held
+func rewardProgress(input MahoganyHomesInput, totalPoints, pointsPerContract int, contractsPerHour float64) []RewardProgress {
+	progress := make([]RewardProgress, 0, len(CarpenterRewards))
+	cumulative := 0
+	for _, reward := range CarpenterRewards {
+		owned := reward.Piece == "" && input.PlankSack || reward.Piece != "" && slices.Contains(input.Outfit, reward.Piece)
+		if owned {
+			continue
+		}
+
+		cumulative += reward.Cost
+		contractsToAfford := 0
+		if missing := cumulative - input.StartingPoints; missing > 0 {
+			contractsToAfford = (missing + pointsPerContract - 1) / pointsPerContract
+		}
+		progress = append(progress, RewardProgress{
+			CarpenterReward:   reward,
+			CumulativeCost:    cumulative,
+			ContractsToAfford: contractsToAfford,
+			HoursToAfford:     float64(contractsToAfford) / contractsPerHour,
+			Affordable:        totalPoints >= cumulative,
+		})
+	}
+	return progress
+}
+
+// GetCalculationProTips provides detailed information about how Mahogany Homes calculations work
+func GetCalculationProTips() map[string]any {
+	return map[string]any{
+		"calculation_methodology": map[string]any{
+			"xp_rates_source": "Contract tiers, plank XP and carpenter rewards from the OSRS Wiki",
+			"base_formula":    "XP per contract = plank XP × planks per contract × (1 + outfit bonus), × contracts per hour",
+			"data_points": []map[string]any{
+				{"tier": "beginner", "plank": "Plank", "xp_per_hour": 10000},
+				{"tier": "novice", "plank": "Oak plank", "xp_per_hour": 19900},
+				{"tier": "adept", "plank": "Teak plank", "setup": "plank sack", "xp_per_hour": 38500},
+				{"tier": "expert", "plank": "Mahogany plank", "setup": "plank sack, full outfit", "xp_per_hour": 94700},
+			},
+		},
+		"game_mechanics": map[string]any{
+			"tiers":      "Beginner, novice, adept and expert contracts need 1, 20, 50 and 70 Construction",
+			"planks":     "Each tier uses one plank type: planks, oak, teak and mahogany",
+			"steel_bars": "Some furniture also needs steel bars, which give no XP",
+			"points":     "Contracts give 2, 3, 4 or 5 carpenter points by tier",
+		},
+		"factors_considered": []string{
+			"Contract tier and plank type",
+			"Carpenter's outfit pieces (up to 2.5% bonus XP)",
+			"Plank sack (fewer bank trips)",
+			"Plank and steel bar prices",
+			"Carpenter points already held",
+		},
+		"accuracy_notes": map[string]any{
+			"rates_vary": "Contract times depend on the house:",
+			"variance_factors": []string{
+				"Distance to the assigned house",
+				"Teleports available for each city",
+				"How many hotspots need repairing instead of building",
+			},
+			"calculation_basis": "Average planks and steel bars over the houses of the tier",
+		},
+		"pro_tips": []map[string]string{
+			{
+				"tip":         "Buy the Plank Sack First",
+				"description": "The plank sack holds extra planks, saving bank trips on every contract",
+			},
+			{
+				"tip":         "Cheaper Than the House",
+				"description": "Contracts give more XP per plank than oak larders or mahogany tables, at a slower pace",
+			},
+			{
+				"tip":         "Wear the Outfit Everywhere",
+				"description": "The carpenter's outfit bonus also applies to building in your house",
+			},
+		},
+		"reward_calculation": map[string]any{
+			"cost_per_contract": "Planks × plank price + steel bars × steel bar price",
+			"gp_per_xp":         "Cost per contract ÷ XP per contract, compared with the house methods over the same XP",
+			"rewards":           "Carpenter rewards not yet owned are bought in order: plank sack, then the outfit",
+		},
+	}
+}
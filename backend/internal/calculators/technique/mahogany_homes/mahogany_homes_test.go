@@ -0,0 +1,145 @@
+package mahoganyhomes
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalculateMahoganyHomesData(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       MahoganyHomesInput
+		expectError bool
+	}{
+		{
+			name:  "Beginner contracts",
+			input: MahoganyHomesInput{CurrentLevel: 1, TargetLevel: 20, Tier: "beginner"},
+		},
+		{
+			name:  "Expert contracts with the full outfit",
+			input: MahoganyHomesInput{CurrentLevel: 70, TargetLevel: 99, Tier: "expert", PlankSack: true, Outfit: []string{"helmet", "shirt", "trousers", "boots"}},
+		},
+		{
+			name:  "Tier from the plank type",
+			input: MahoganyHomesInput{CurrentLevel: 55, TargetLevel: 60, Plank: "Teak plank"},
+		},
+		{
+			name:        "Plank does not match the tier",
+			input:       MahoganyHomesInput{CurrentLevel: 75, TargetLevel: 80, Tier: "expert", Plank: "Oak plank"},
+			expectError: true,
+		},
+		{
+			name:        "Level too low for the tier",
+			input:       MahoganyHomesInput{CurrentLevel: 40, TargetLevel: 60, Tier: "adept"},
+			expectError: true,
+		},
+		{
+			name:        "Invalid outfit piece",
+			input:       MahoganyHomesInput{CurrentLevel: 40, TargetLevel: 60, Tier: "novice", Outfit: []string{"gloves"}},
+			expectError: true,
+		},
+		{
+			name:        "No tier or plank",
+			input:       MahoganyHomesInput{CurrentLevel: 40, TargetLevel: 60},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculateMahoganyHomesData(tt.input)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result.XPPerHour <= 0 || result.HoursNeeded <= 0 {
+				t.Errorf("Expected positive XP per hour and hours, got %f and %f", result.XPPerHour, result.HoursNeeded)
+			}
+			if float64(result.ContractsNeeded)*result.XPPerContract < float64(result.XPNeeded) {
+				t.Errorf("%d contracts of %.0f XP do not reach %d XP", result.ContractsNeeded, result.XPPerContract, result.XPNeeded)
+			}
+			if len(result.Comparisons) != len(comparisonMethodIDs) {
+				t.Errorf("Expected %d comparisons, got %d", len(comparisonMethodIDs), len(result.Comparisons))
+			}
+		})
+	}
+}
+
+func TestOutfitBonus(t *testing.T) {
+	tests := []struct {
+		pieces   []string
+		expected float64
+	}{
+		{nil, 0},
+		{[]string{"helmet"}, 0.004},
+		{[]string{"helmet", "shirt", "trousers"}, 0.018},
+		{[]string{"helmet", "shirt", "trousers", "boots"}, FullOutfitBonus},
+	}
+
+	for _, tt := range tests {
+		if got := OutfitBonus(tt.pieces); math.Abs(got-tt.expected) > 1e-9 {
+			t.Errorf("OutfitBonus(%v) = %f, want %f", tt.pieces, got, tt.expected)
+		}
+	}
+}
+
+func TestRewardsSkipOwnedItems(t *testing.T) {
+	result, err := CalculateMahoganyHomesData(MahoganyHomesInput{
+		CurrentLevel:   70,
+		TargetLevel:    75,
+		Tier:           "expert",
+		PlankSack:      true,
+		Outfit:         []string{"helmet"},
+		StartingPoints: 100,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(result.Rewards) != 3 {
+		t.Fatalf("Expected 3 rewards left to buy, got %d", len(result.Rewards))
+	}
+	first := result.Rewards[0]
+	if first.Name != "Carpenter's boots" || first.ContractsToAfford != 20 {
+		t.Errorf("Expected boots after 20 contracts, got %s after %d", first.Name, first.ContractsToAfford)
+	}
+}
+
+func TestComparisonMethodsFromSkillData(t *testing.T) {
+	methods, err := ComparisonMethods()
+	if err != nil {
+		t.Fatalf("Failed to load comparison methods: %v", err)
+	}
+
+	expected := map[string]struct {
+		plank  string
+		planks int
+	}{
+		"oak_larders":     {"Oak plank", 8},
+		"mahogany_tables": {"Mahogany plank", 6},
+	}
+	if len(methods) != len(expected) {
+		t.Fatalf("Expected %d methods, got %d", len(expected), len(methods))
+	}
+	for _, method := range methods {
+		want, ok := expected[method.ID]
+		if !ok {
+			t.Errorf("Unexpected method %s", method.ID)
+			continue
+		}
+		if method.Plank != want.plank || method.Planks != want.planks {
+			t.Errorf("%s: expected %d %s, got %d %s", method.ID, want.planks, want.plank, method.Planks, method.Plank)
+		}
+		if method.XPPerAction <= 0 || method.XPPerHour <= 0 || method.PlankValue <= 0 {
+			t.Errorf("%s: expected XP and plank value from the skill data, got %+v", method.ID, method)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package motherlode
+
+import (
+	"fmt"
+
+	"osrs-xp-kits/internal/calculators"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+// MotherlodeInput is the request body accepted by the Motherlode Mine calculator
+type MotherlodeInput struct {
+	CurrentLevel int    `json:"current_level"`
+	TargetLevel  int    `json:"target_level"`
+	Area         string `json:"area"`
+	Pickaxe      string `json:"pickaxe"`
+	LargerSack   bool   `json:"larger_sack"`
+}
+
+// Calculator exposes Motherlode Mine through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the Motherlode Mine calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "motherlode",
+		Name:        "Motherlode Mine",
+		Description: "Mining XP, ore, golden nuggets toward the prospector outfit and Rock golem odds at the Motherlode Mine",
+		Category:    "skilling",
+		Skills:      []string{"mining"},
+	}
+}
+
+// Validate checks the Motherlode Mine input
+func (Calculator) Validate(input MotherlodeInput) error {
+	if input.CurrentLevel < MinimumMiningLevel {
+		return fmt.Errorf("mining level must be at least %d", MinimumMiningLevel)
+	}
+	if input.TargetLevel < input.CurrentLevel {
+		return fmt.Errorf("target level must be greater than or equal to current level")
+	}
+	if input.TargetLevel > xp.MaxVirtualLevel {
+		return fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+	if Area(input.Area) != AreaLower && Area(input.Area) != AreaUpper {
+		return fmt.Errorf("invalid area: %s", input.Area)
+	}
+	if _, exists := Pickaxes[input.Pickaxe]; !exists {
+		return fmt.Errorf("invalid pickaxe: %s", input.Pickaxe)
+	}
+	return nil
+}
+
+// Calculate runs the Motherlode Mine calculation
+func (Calculator) Calculate(input MotherlodeInput, opts calculators.Options) (MotherlodeResult, error) {
+	return CalculateMotherlodeDataWithPrices(
+		input.CurrentLevel,
+		input.TargetLevel,
+		Area(input.Area),
+		input.Pickaxe,
+		input.LargerSack,
+		opts.Prices,
+	)
+}
+
+// ProTips returns the Motherlode Mine calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
@@ -0,0 +1,83 @@
+package motherlode
+
+import "osrs-xp-kits/internal/calculators/pets"
+
+// RockGolem is rolled once per pay-dirt mined, with better odds at higher Mining levels
+var RockGolem = pets.Pet{Name: "Rock golem", BaseRate: 247200}
+
+// Ore is a possible result of cleaning one pay-dirt
+type Ore struct {
+	Name  string
+	Level int     // Mining level needed to receive the ore
+	XP    float64 // Mining XP when the ore is collected from the sack
+	Value int     // Default price when live prices are unavailable
+	// Weight is the ore's share of pay-dirt once unlocked. Higher ores are rarer.
+	Weight float64
+}
+
+// Ores in the order they unlock
+var Ores = []Ore{
+	{"Coal", 30, 30, 150, 60},
+	{"Gold ore", 40, 65, 150, 20},
+	{"Mithril ore", 55, 80, 180, 12},
+	{"Adamantite ore", 70, 95, 800, 6},
+	{"Runite ore", 85, 125, 11000, 2},
+}
+
+// Mining and sack constants based on OSRS Wiki
+const (
+	MinimumMiningLevel = 30
+	UpperLevelRequired = 72
+
+	// PaydirtXP is the Mining XP for mining one pay-dirt, before the ore is collected
+	PaydirtXP = 60.0
+
+	// Pay-dirt mined per hour with a dragon pickaxe on the lower level, before banking
+	BasePaydirtPerHour   = 200.0
+	PaydirtPerHourPerLvl = 3.5
+	UpperLevelMultiplier = 1.1 // Fewer players and longer-lasting veins
+
+	// Sack capacity in pay-dirt
+	SackCapacity       = 108
+	LargerSackCapacity = 189
+
+	// Time spent away from the veins
+	InventorySize     = 28
+	HopperTripMinutes = 0.5 // Depositing one inventory in the hopper
+	SackEmptyMinutes  = 4.0 // Emptying a full sack and banking the ore
+
+	// GoldenNuggetChance is the chance of a golden nugget per pay-dirt cleaned
+	GoldenNuggetChance = 1.0 / 40.0
+)
+
+// Pickaxe speed relative to a dragon pickaxe
+var Pickaxes = map[string]struct {
+	Level      int
+	Multiplier float64
+}{
+	"bronze":  {1, 0.60},
+	"iron":    {1, 0.65},
+	"steel":   {6, 0.70},
+	"black":   {11, 0.72},
+	"mithril": {21, 0.75},
+	"adamant": {31, 0.80},
+	"rune":    {41, 0.90},
+	"dragon":  {61, 1.00},
+	"crystal": {71, 1.05},
+}
+
+// ProspectorPiece is a piece of the prospector outfit bought with golden nuggets
+type ProspectorPiece struct {
+	Name    string  `json:"name"`
+	Cost    int     `json:"cost"`
+	XPBonus float64 `json:"xp_bonus"` // percent
+}
+
+// ProspectorOutfit in the order worth buying, largest XP bonus per nugget first.
+// The full set gives 2.5% instead of the 2.0% sum of the pieces.
+var ProspectorOutfit = []ProspectorPiece{
+	{"Prospector jacket", 60, 0.8},
+	{"Prospector legs", 50, 0.6},
+	{"Prospector helmet", 40, 0.4},
+	{"Prospector boots", 30, 0.2},
+}
@@ -0,0 +1,332 @@
+package motherlode
+
+import (
+	"fmt"
+	"math"
+
+	"osrs-xp-kits/internal/calculators/pets"
+	"osrs-xp-kits/internal/calculators/probability"
+	"osrs-xp-kits/internal/calculators/progression"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+// Area is the level of the mine being worked
+type Area string
+
+const (
+	AreaLower Area = "lower"
+	AreaUpper Area = "upper"
+)
+
+type MotherlodeResult struct {
+	CurrentLevel     int         `json:"current_level"`
+	TargetLevel      int         `json:"target_level"`
+	XPNeeded         int         `json:"xp_needed"`
+	Area             string      `json:"area"`
+	Pickaxe          string      `json:"pickaxe"`
+	SackCapacity     int         `json:"sack_capacity"`
+	PaydirtNeeded    int         `json:"paydirt_needed"`
+	PaydirtPerHour   float64     `json:"paydirt_per_hour"`
+	HoursNeeded      float64     `json:"hours_needed"`
+	AverageXPPerHour float64     `json:"average_xp_per_hour"`
+	OreDistribution  []OreChance `json:"ore_distribution"`
+	Ores             []OreYield  `json:"ores"`
+	TotalValue       int         `json:"total_value"`
+	GPPerHour        float64     `json:"gp_per_hour"`
+	PetChance        float64     `json:"pet_chance"`
+
+	GoldenNuggets  int                  `json:"golden_nuggets"`
+	NuggetsPerHour float64              `json:"nuggets_per_hour"`
+	Prospector     []ProspectorProgress `json:"prospector"`
+
+	Progression progression.Progression `json:"progression"`
+	UniqueOdds  []probability.DropOdds  `json:"unique_odds"`
+	PetOdds     pets.Odds               `json:"pet_odds"`
+}
+
+// OreChance is the chance of an ore per pay-dirt at one Mining level
+type OreChance struct {
+	Name   string  `json:"name"`
+	Chance float64 `json:"chance"`
+}
+
+// OreYield is the expected amount of one ore collected over the whole grind
+type OreYield struct {
+	Name       string `json:"name"`
+	Quantity   int    `json:"quantity"`
+	Value      int    `json:"value"`
+	TotalValue int    `json:"total_value"`
+}
+
+// ProspectorProgress tracks when a prospector piece can be bought, buying pieces in order
+type ProspectorProgress struct {
+	ProspectorPiece
+	CumulativeCost int     `json:"cumulative_cost"`
+	HoursToAfford  float64 `json:"hours_to_afford"`
+	Affordable     bool    `json:"affordable"`
+}
+
+func CalculateMotherlodeData(currentLevel, targetLevel int, area Area, pickaxe string, largerSack bool) (MotherlodeResult, error) {
+	return CalculateMotherlodeDataWithPrices(currentLevel, targetLevel, area, pickaxe, largerSack, nil)
+}
+
+// CalculateMotherlodeDataWithPrices calculates Motherlode Mine data with optional live ore prices
+func CalculateMotherlodeDataWithPrices(currentLevel, targetLevel int, area Area, pickaxe string, largerSack bool, livePrices map[string]int) (MotherlodeResult, error) {
+	if currentLevel < MinimumMiningLevel {
+		return MotherlodeResult{}, fmt.Errorf("mining level must be at least %d", MinimumMiningLevel)
+	}
+
+	if targetLevel < currentLevel {
+		return MotherlodeResult{}, fmt.Errorf("target level must be greater than or equal to current level")
+	}
+
+	if targetLevel > xp.MaxVirtualLevel {
+		return MotherlodeResult{}, fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+
+	switch area {
+	case AreaLower:
+	case AreaUpper:
+		if currentLevel < UpperLevelRequired {
+			return MotherlodeResult{}, fmt.Errorf("the upper level requires %d Mining", UpperLevelRequired)
+		}
+	default:
+		return MotherlodeResult{}, fmt.Errorf("invalid area: %s", area)
+	}
+
+	pickaxeInfo, exists := Pickaxes[pickaxe]
+	if !exists {
+		return MotherlodeResult{}, fmt.Errorf("invalid pickaxe: %s", pickaxe)
+	}
+	if currentLevel < pickaxeInfo.Level {
+		return MotherlodeResult{}, fmt.Errorf("%s pickaxe requires %d Mining", pickaxe, pickaxeInfo.Level)
+	}
+
+	capacity := SackCapacity
+	if largerSack {
+		capacity = LargerSackCapacity
+	}
+
+	rate := func(level int) progression.Rate {
+		return progression.Rate{
+			XPPerAction:    xpPerPaydirt(level),
+			ActionsPerHour: paydirtPerHour(level, pickaxeInfo.Multiplier, area, capacity),
+			SuccessChance:  1,
+		}
+	}
+
+	// Walk level by level so the ore mix, XP and mining speed follow the player's level
+	currentXP := xp.ForLevel(currentLevel)
+	targetXP := xp.ForLevel(targetLevel)
+	levelProgression, err := progression.Walk(currentXP, targetXP, rate)
+	if err != nil {
+		return MotherlodeResult{}, err
+	}
+
+	paydirtNeeded := int(math.Ceil(levelProgression.TotalActions))
+
+	// Collect the expected ore from every level of the grind
+	oreTotals := make(map[string]float64, len(Ores))
+	for _, segment := range levelProgression.Segments {
+		for _, ore := range OreDistribution(min(segment.Level, xp.MaxLevel)) {
+			oreTotals[ore.Name] += segment.Actions * ore.Chance
+		}
+	}
+
+	ores := make([]OreYield, 0, len(Ores))
+	totalValue := 0
+	for _, ore := range Ores {
+		quantity := int(math.Round(oreTotals[ore.Name]))
+		if quantity == 0 {
+			continue
+		}
+
+		price := ore.Value
+		if livePrice, exists := livePrices[ore.Name]; exists {
+			price = livePrice
+		}
+
+		ores = append(ores, OreYield{
+			Name:       ore.Name,
+			Quantity:   quantity,
+			Value:      price,
+			TotalValue: quantity * price,
+		})
+		totalValue += quantity * price
+	}
+
+	startRate := rate(min(currentLevel, xp.MaxLevel))
+
+	hoursNeeded := levelProgression.TotalHours
+	gpPerHour := 0.0
+	nuggetsPerHour := startRate.ActionsPerHour * GoldenNuggetChance
+	if hoursNeeded > 0 {
+		gpPerHour = float64(totalValue) / hoursNeeded
+		nuggetsPerHour = levelProgression.TotalActions * GoldenNuggetChance / hoursNeeded
+	}
+	goldenNuggets := int(math.Round(levelProgression.TotalActions * GoldenNuggetChance))
+
+	// Pet chance calculation, one roll per pay-dirt at the level it is mined at
+	petOdds, err := RockGolem.OverProgression(currentXP, levelProgression, 1)
+	if err != nil {
+		return MotherlodeResult{}, err
+	}
+
+	uniqueOdds, err := probability.UniqueOdds([]probability.Unique{{Name: RockGolem.Name, Rate: petOdds.EffectiveRate}}, paydirtNeeded)
+	if err != nil {
+		return MotherlodeResult{}, err
+	}
+
+	return MotherlodeResult{
+		CurrentLevel:     currentLevel,
+		TargetLevel:      targetLevel,
+		XPNeeded:         targetXP - currentXP,
+		Area:             string(area),
+		Pickaxe:          pickaxe,
+		SackCapacity:     capacity,
+		PaydirtNeeded:    paydirtNeeded,
+		PaydirtPerHour:   startRate.ActionsPerHour,
+		HoursNeeded:      hoursNeeded,
+		AverageXPPerHour: levelProgression.AverageXPPerHour,
+		OreDistribution:  OreDistribution(currentLevel),
+		Ores:             ores,
+		TotalValue:       totalValue,
+		GPPerHour:        gpPerHour,
+		PetChance:        petOdds.Chance * 100, // Convert to percentage
+		GoldenNuggets:    goldenNuggets,
+		NuggetsPerHour:   nuggetsPerHour,
+		Prospector:       prospectorProgress(goldenNuggets, nuggetsPerHour),
+		Progression:      levelProgression,
+		UniqueOdds:       uniqueOdds,
+		PetOdds:          petOdds,
+	}, nil
+}
+
+// UniqueDropOdds returns the odds of getting the Rock golem
+func (r MotherlodeResult) UniqueDropOdds() []probability.DropOdds {
+	return r.UniqueOdds
+}
+
+// OreDistribution returns the chance of each unlocked ore per pay-dirt at a Mining level
+func OreDistribution(level int) []OreChance {
+	totalWeight := 0.0
+	for _, ore := range Ores {
+		if level >= ore.Level {
+			totalWeight += ore.Weight
+		}
+	}
+
+	distribution := make([]OreChance, 0, len(Ores))
+	for _, ore := range Ores {
+		if level >= ore.Level {
+			distribution = append(distribution, OreChance{Name: ore.Name, Chance: ore.Weight / totalWeight})
+		}
+	}
+	return distribution
+}
+
+// xpPerPaydirt returns the Mining XP for one pay-dirt, including the expected XP of its ore
+func xpPerPaydirt(level int) float64 {
+	oreXP := make(map[string]float64, len(Ores))
+	for _, ore := range Ores {
+		oreXP[ore.Name] = ore.XP
+	}
+
+	total := PaydirtXP
+	for _, ore := range OreDistribution(level) {
+		total += ore.Chance * oreXP[ore.Name]
+	}
+	return total
+}
+
+// paydirtPerHour returns the pay-dirt mined per hour once hopper trips and emptying the sack are included
+func paydirtPerHour(level int, pickaxeMultiplier float64, area Area, capacity int) float64 {
+	miningRate := (BasePaydirtPerHour + float64(level)*PaydirtPerHourPerLvl) * pickaxeMultiplier
+	if area == AreaUpper {
+		miningRate *= UpperLevelMultiplier
+	}
+
+	// Minutes per pay-dirt spent mining plus its share of the trips away from the veins
+	minutesPerPaydirt := 60/miningRate + HopperTripMinutes/InventorySize + SackEmptyMinutes/float64(capacity)
+
+	return 60 / minutesPerPaydirt
+}
+
+// prospectorProgress returns when each prospector piece can be bought with the nuggets earned
+func prospectorProgress(nuggets int, nuggetsPerHour float64) []ProspectorProgress {
+	progress := make([]ProspectorProgress, 0, len(ProspectorOutfit))
+	cumulative := 0
+	for _, piece := range ProspectorOutfit {
+		cumulative += piece.Cost
+
+		hours := 0.0
+		if nuggetsPerHour > 0 {
+			hours = float64(cumulative) / nuggetsPerHour
+		}
+
+		progress = append(progress, ProspectorProgress{
+			ProspectorPiece: piece,
+			CumulativeCost:  cumulative,
+			HoursToAfford:   hours,
+			Affordable:      nuggets >= cumulative,
+		})
+	}
+	return progress
+}
+
+// GetCalculationProTips provides detailed information about how Motherlode Mine calculations work
+func GetCalculationProTips() map[string]any {
+	return map[string]any{
+		"calculation_methodology": map[string]any{
+			"xp_rates_source": "Based on official Motherlode Mine mechanics and community data",
+			"base_formula":    "(60 XP per pay-dirt + expected ore XP) × pay-dirt per hour, applied level by level",
+			"data_points": []map[string]any{
+				{"level": 30, "pickaxe": "mithril", "xp_per_hour": 17000, "note": "Minimum access level, coal only"},
+				{"level": 61, "pickaxe": "dragon", "xp_per_hour": 31000, "note": "Dragon pickaxe unlocked"},
+				{"level": 85, "pickaxe": "dragon", "xp_per_hour": 37000, "note": "Runite ore unlocked"},
+				{"level": 99, "pickaxe": "crystal", "xp_per_hour": 49000, "note": "Upper level with the larger sack"},
+			},
+		},
+		"game_mechanics": map[string]any{
+			"paydirt":       "Each pay-dirt gives 60 Mining XP, then one ore with its own Mining XP when collected from the sack",
+			"ore_unlocks":   "Coal at 30, gold at 40, mithril at 55, adamantite at 70 and runite at 85 Mining",
+			"sack_capacity": "The sack holds 108 pay-dirt, or 189 with the larger sack upgrade",
+			"upper_level":   "Requires 72 Mining and 100 golden nuggets to unlock",
+		},
+		"factors_considered": []string{
+			"Mining level (affects mining speed, ore mix and XP per pay-dirt)",
+			"Pickaxe (affects mining speed)",
+			"Sack capacity (affects how often mining stops to empty the sack)",
+			"Golden nuggets toward the prospector outfit",
+			"Level-scaled Rock golem pet chance per pay-dirt",
+		},
+		"accuracy_notes": map[string]any{
+			"rates_vary": "Individual XP rates can vary ±15% based on:",
+			"variance_factors": []string{
+				"Competition for veins on busy worlds",
+				"Broken struts delaying the water wheels",
+				"How attentive the player is",
+			},
+			"calculation_basis": "Rates assume a quiet world and no hammer repairs",
+		},
+		"pro_tips": []map[string]string{
+			{
+				"tip":         "Larger Sack First",
+				"description": "The larger sack means fewer trips to the bank and more time mining",
+			},
+			{
+				"tip":         "Prospector Outfit",
+				"description": "Buy the jacket first, it gives the largest XP bonus per nugget",
+			},
+			{
+				"tip":         "Upper Level",
+				"description": "From 72 Mining the upper level has fewer players and veins that last longer",
+			},
+		},
+		"reward_calculation": map[string]any{
+			"ore_values":    "Live OSRS Wiki API prices when available, static estimates as fallback",
+			"nugget_chance": "1 golden nugget per 40 pay-dirt on average",
+			"gp_per_hour":   "Calculated from ore value averaged over time",
+		},
+	}
+}
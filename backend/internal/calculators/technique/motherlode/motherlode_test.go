@@ -0,0 +1,141 @@
+package motherlode
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalculateMotherlodeData(t *testing.T) {
+	tests := []struct {
+		name         string
+		currentLevel int
+		targetLevel  int
+		area         Area
+		pickaxe      string
+		largerSack   bool
+		expectError  bool
+	}{
+		{
+			name:         "Level 30 to 60 lower level",
+			currentLevel: 30,
+			targetLevel:  60,
+			area:         AreaLower,
+			pickaxe:      "mithril",
+		},
+		{
+			name:         "Level 72 to 99 upper level with larger sack",
+			currentLevel: 72,
+			targetLevel:  99,
+			area:         AreaUpper,
+			pickaxe:      "crystal",
+			largerSack:   true,
+		},
+		{
+			name:         "Invalid level (too low)",
+			currentLevel: 29,
+			targetLevel:  60,
+			area:         AreaLower,
+			pickaxe:      "bronze",
+			expectError:  true,
+		},
+		{
+			name:         "Upper level below 72",
+			currentLevel: 70,
+			targetLevel:  80,
+			area:         AreaUpper,
+			pickaxe:      "dragon",
+			expectError:  true,
+		},
+		{
+			name:         "Pickaxe level too high",
+			currentLevel: 50,
+			targetLevel:  60,
+			area:         AreaLower,
+			pickaxe:      "dragon",
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculateMotherlodeData(tt.currentLevel, tt.targetLevel, tt.area, tt.pickaxe, tt.largerSack)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result.PaydirtNeeded <= 0 || result.HoursNeeded <= 0 {
+				t.Errorf("Expected positive pay-dirt and hours, got %d and %.2f", result.PaydirtNeeded, result.HoursNeeded)
+			}
+
+			oreTotal := 0
+			for _, ore := range result.Ores {
+				oreTotal += ore.Quantity
+			}
+			if math.Abs(float64(oreTotal-result.PaydirtNeeded)) > float64(len(result.Ores)+1) {
+				t.Errorf("Every pay-dirt should give one ore: %d ore for %d pay-dirt", oreTotal, result.PaydirtNeeded)
+			}
+
+			if len(result.Prospector) != len(ProspectorOutfit) {
+				t.Errorf("Expected progress for %d prospector pieces, got %d", len(ProspectorOutfit), len(result.Prospector))
+			}
+		})
+	}
+}
+
+func TestOreDistribution(t *testing.T) {
+	tests := []struct {
+		level   int
+		ores    int
+		bestOre string
+	}{
+		{30, 1, "Coal"},
+		{54, 2, "Gold ore"},
+		{70, 4, "Adamantite ore"},
+		{99, 5, "Runite ore"},
+	}
+
+	for _, tt := range tests {
+		distribution := OreDistribution(tt.level)
+		if len(distribution) != tt.ores {
+			t.Errorf("Level %d: expected %d ores, got %d", tt.level, tt.ores, len(distribution))
+			continue
+		}
+		if best := distribution[len(distribution)-1].Name; best != tt.bestOre {
+			t.Errorf("Level %d: expected best ore %s, got %s", tt.level, tt.bestOre, best)
+		}
+
+		total := 0.0
+		for _, ore := range distribution {
+			total += ore.Chance
+		}
+		if math.Abs(total-1) > 1e-9 {
+			t.Errorf("Level %d: chances should sum to 1, got %f", tt.level, total)
+		}
+	}
+}
+
+func TestLargerSackIncreasesPaydirtPerHour(t *testing.T) {
+	small, err := CalculateMotherlodeData(80, 85, AreaLower, "dragon", false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	large, err := CalculateMotherlodeData(80, 85, AreaLower, "dragon", true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if large.PaydirtPerHour <= small.PaydirtPerHour {
+		t.Errorf("The larger sack should mean more pay-dirt per hour: %.1f vs %.1f", large.PaydirtPerHour, small.PaydirtPerHour)
+	}
+	if large.SackCapacity != LargerSackCapacity {
+		t.Errorf("Expected sack capacity %d, got %d", LargerSackCapacity, large.SackCapacity)
+	}
+}
@@ -0,0 +1,56 @@
+package prayer
+
+import (
+	"fmt"
+
+	"osrs-xp-kits/internal/calculators"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+// PrayerInput is the request body accepted by the Prayer calculator
+type PrayerInput struct {
+	CurrentLevel int    `json:"current_level"`
+	TargetLevel  int    `json:"target_level"`
+	Bone         string `json:"bone"`
+}
+
+// Calculator exposes Prayer through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the Prayer calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "prayer",
+		Name:        "Prayer",
+		Description: "Bones needed, total cost, GP/XP and hours for burying, altars, the Ectofuntus and offering spells",
+		Category:    "skilling",
+		Skills:      []string{"prayer"},
+	}
+}
+
+// Validate checks the Prayer input
+func (Calculator) Validate(input PrayerInput) error {
+	if input.CurrentLevel < 1 {
+		return fmt.Errorf("prayer level must be at least 1")
+	}
+	if input.TargetLevel <= input.CurrentLevel {
+		return fmt.Errorf("target level must be greater than current level")
+	}
+	if input.TargetLevel > xp.MaxVirtualLevel {
+		return fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+	if _, exists := Bones[input.Bone]; !exists {
+		return fmt.Errorf("invalid bone type: %s", input.Bone)
+	}
+	return nil
+}
+
+// Calculate runs the Prayer calculation
+func (Calculator) Calculate(input PrayerInput, opts calculators.Options) (PrayerResult, error) {
+	return CalculatePrayerDataWithPrices(input.CurrentLevel, input.TargetLevel, input.Bone, opts.Prices)
+}
+
+// ProTips returns the Prayer calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
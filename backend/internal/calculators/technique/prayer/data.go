@@ -0,0 +1,92 @@
+package prayer
+
+// Bone is a bone or ash that can be trained with
+type Bone struct {
+	Name  string // Item name, used for live prices
+	XP    float64
+	Ashes bool // Ashes are scattered or offered with Demonic Offering instead of used on altars
+	Value int  // Default price when live prices are unavailable
+}
+
+// Bones keyed by the name used in requests
+var Bones = map[string]Bone{
+	"bones":                 {"Bones", 4.5, false, 100},
+	"big_bones":             {"Big bones", 15, false, 300},
+	"babydragon_bones":      {"Babydragon bones", 30, false, 1500},
+	"wyrm_bones":            {"Wyrm bones", 50, false, 1000},
+	"dragon_bones":          {"Dragon bones", 72, false, 2500},
+	"wyvern_bones":          {"Wyvern bones", 72, false, 2000},
+	"drake_bones":           {"Drake bones", 80, false, 4500},
+	"lava_dragon_bones":     {"Lava dragon bones", 85, false, 2800},
+	"hydra_bones":           {"Hydra bones", 110, false, 5000},
+	"dagannoth_bones":       {"Dagannoth bones", 125, false, 5500},
+	"superior_dragon_bones": {"Superior dragon bones", 150, false, 12000},
+
+	"fiendish_ashes":  {"Fiendish ashes", 10, true, 50},
+	"vile_ashes":      {"Vile ashes", 25, true, 150},
+	"malicious_ashes": {"Malicious ashes", 65, true, 1400},
+	"abyssal_ashes":   {"Abyssal ashes", 85, true, 2000},
+	"infernal_ashes":  {"Infernal ashes", 110, true, 2500},
+}
+
+// Cost is an item used up alongside each bone
+type Cost struct {
+	Item    string
+	PerBone float64
+	Value   int // Default price when live prices are unavailable
+}
+
+// Method is a way of turning bones into Prayer XP
+type Method struct {
+	ID           string
+	Name         string
+	XPMultiplier float64
+	SaveChance   float64 // Chance the bone is not used up
+	BonesPerHour float64 // Bones offered per hour, saved bones included
+	ForBones     bool
+	ForAshes     bool
+	MagicLevel   int     // Magic level needed for offering spells
+	MagicXP      float64 // Magic XP per bone offered
+	Costs        []Cost
+}
+
+// Methods in the order they are compared
+var Methods = []Method{
+	{
+		ID: "bury", Name: "Bury or scatter", XPMultiplier: 1, BonesPerHour: 1400,
+		ForBones: true, ForAshes: true,
+	},
+	{
+		ID: "gilded_altar", Name: "Gilded altar", XPMultiplier: 3.5, BonesPerHour: 1900,
+		ForBones: true,
+		Costs:    []Cost{{"Coins", 1, 5}}, // Phials unnotes bones for 5 coins each
+	},
+	{
+		ID: "chaos_altar", Name: "Chaos altar", XPMultiplier: 3.5, SaveChance: 0.5, BonesPerHour: 1800,
+		ForBones: true,
+		Costs:    []Cost{{"Coins", 1, 50}}, // The Elder Chaos druid unnotes bones for 50 coins each
+	},
+	{
+		ID: "ectofuntus", Name: "Ectofuntus", XPMultiplier: 4, BonesPerHour: 450,
+		ForBones: true,
+		Costs:    []Cost{{"Pot", 1, 20}, {"Bucket of slime", 1, 200}},
+	},
+	{
+		ID: "sinister_offering", Name: "Sinister Offering", XPMultiplier: 3, BonesPerHour: 2400,
+		ForBones: true, MagicLevel: 92, MagicXP: 60,
+		Costs: []Cost{{"Blood rune", 1.0 / 3, 400}, {"Wrath rune", 1.0 / 3, 450}},
+	},
+	{
+		ID: "demonic_offering", Name: "Demonic Offering", XPMultiplier: 3, BonesPerHour: 2400,
+		ForAshes: true, MagicLevel: 84, MagicXP: 58.3,
+		Costs: []Cost{{"Soul rune", 1.0 / 3, 250}, {"Wrath rune", 1.0 / 3, 450}},
+	},
+}
+
+// Applies reports whether the method can be used with the bone
+func (m Method) Applies(bone Bone) bool {
+	if bone.Ashes {
+		return m.ForAshes
+	}
+	return m.ForBones
+}
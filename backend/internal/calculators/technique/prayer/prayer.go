@@ -0,0 +1,185 @@
+package prayer
+
+import (
+	"fmt"
+	"math"
+
+	"osrs-xp-kits/internal/calculators/tools"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+type PrayerResult struct {
+	CurrentLevel  int            `json:"current_level"`
+	TargetLevel   int            `json:"target_level"`
+	XPNeeded      int            `json:"xp_needed"`
+	Bone          string         `json:"bone"`
+	BonePrice     int            `json:"bone_price"`
+	Methods       []MethodResult `json:"methods"`
+	Fastest       string         `json:"fastest"`         // Method ID with the fewest hours
+	CheapestPerXP string         `json:"cheapest_per_xp"` // Method ID with the lowest GP/XP
+}
+
+// MethodResult is the cost and time of reaching the target with one method
+type MethodResult struct {
+	Method      string  `json:"method"`
+	Name        string  `json:"name"`
+	XPPerBone   float64 `json:"xp_per_bone"` // XP per bone offered
+	SaveChance  float64 `json:"save_chance"`
+	BonesNeeded int     `json:"bones_needed"` // Bones used up, after saved bones
+	BoneCost    int     `json:"bone_cost"`
+	ExtraCost   int     `json:"extra_cost"` // Coins, secondaries and runes
+	TotalCost   int     `json:"total_cost"`
+	GPPerXP     float64 `json:"gp_per_xp"`
+	Hours       float64 `json:"hours"`
+	XPPerHour   float64 `json:"xp_per_hour"`
+	MagicLevel  int     `json:"magic_level,omitempty"`
+	MagicXP     float64 `json:"magic_xp,omitempty"`
+}
+
+func CalculatePrayerData(currentLevel, targetLevel int, boneName string) (PrayerResult, error) {
+	return CalculatePrayerDataWithPrices(currentLevel, targetLevel, boneName, nil)
+}
+
+// CalculatePrayerDataWithPrices compares every method that accepts the bone with optional live prices
+func CalculatePrayerDataWithPrices(currentLevel, targetLevel int, boneName string, livePrices map[string]int) (PrayerResult, error) {
+	if currentLevel < 1 {
+		return PrayerResult{}, fmt.Errorf("prayer level must be at least 1")
+	}
+
+	if targetLevel <= currentLevel {
+		return PrayerResult{}, fmt.Errorf("target level must be greater than current level")
+	}
+
+	if targetLevel > xp.MaxVirtualLevel {
+		return PrayerResult{}, fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+
+	bone, exists := Bones[boneName]
+	if !exists {
+		return PrayerResult{}, fmt.Errorf("invalid bone type: %s", boneName)
+	}
+
+	xpNeeded := xp.ForLevel(targetLevel) - xp.ForLevel(currentLevel)
+	bonePrice := tools.PriceOr(bone.Name, bone.Value, livePrices)
+
+	result := PrayerResult{
+		CurrentLevel: currentLevel,
+		TargetLevel:  targetLevel,
+		XPNeeded:     xpNeeded,
+		Bone:         bone.Name,
+		BonePrice:    bonePrice,
+	}
+
+	for _, method := range Methods {
+		if !method.Applies(bone) {
+			continue
+		}
+
+		xpPerBone := bone.XP * method.XPMultiplier
+		offers := math.Ceil(float64(xpNeeded) / xpPerBone)
+		bonesNeeded := int(math.Ceil(offers * (1 - method.SaveChance)))
+
+		extraPerBone := 0.0
+		for _, cost := range method.Costs {
+			extraPerBone += cost.PerBone * float64(tools.PriceOr(cost.Item, cost.Value, livePrices))
+		}
+		// Runes are spent per offer, everything else per bone used up
+		extraCost := int(math.Round(extraPerBone * float64(bonesNeeded)))
+		if method.MagicLevel > 0 {
+			extraCost = int(math.Round(extraPerBone * offers))
+		}
+
+		boneCost := bonesNeeded * bonePrice
+		totalCost := boneCost + extraCost
+		hours := offers / method.BonesPerHour
+
+		result.Methods = append(result.Methods, MethodResult{
+			Method:      method.ID,
+			Name:        method.Name,
+			XPPerBone:   xpPerBone,
+			SaveChance:  method.SaveChance,
+			BonesNeeded: bonesNeeded,
+			BoneCost:    boneCost,
+			ExtraCost:   extraCost,
+			TotalCost:   totalCost,
+			GPPerXP:     float64(totalCost) / float64(xpNeeded),
+			Hours:       hours,
+			XPPerHour:   xpPerBone * method.BonesPerHour,
+			MagicLevel:  method.MagicLevel,
+			MagicXP:     method.MagicXP * offers,
+		})
+	}
+
+	fastest, cheapest := result.Methods[0], result.Methods[0]
+	for _, method := range result.Methods[1:] {
+		if method.Hours < fastest.Hours {
+			fastest = method
+		}
+		if method.GPPerXP < cheapest.GPPerXP {
+			cheapest = method
+		}
+	}
+	result.Fastest = fastest.Method
+	result.CheapestPerXP = cheapest.Method
+
+	return result, nil
+}
+
+// GetCalculationProTips provides detailed information about how Prayer calculations work
+func GetCalculationProTips() map[string]any {
+	return map[string]any{
+		"calculation_methodology": map[string]any{
+			"xp_rates_source": "Bone XP and altar multipliers from the OSRS Wiki",
+			"base_formula":    "Bones needed = XP needed ÷ (bone XP × method multiplier) × (1 - save chance)",
+			"data_points": []map[string]any{
+				{"bone": "dragon_bones", "method": "gilded_altar", "xp_per_bone": 252, "xp_per_hour": 478800},
+				{"bone": "dragon_bones", "method": "chaos_altar", "xp_per_bone": 252, "xp_per_hour": 453600},
+				{"bone": "dragon_bones", "method": "ectofuntus", "xp_per_bone": 288, "xp_per_hour": 129600},
+			},
+		},
+		"game_mechanics": map[string]any{
+			"multipliers": "Burying gives 100% XP, offerings 300%, the gilded and chaos altars 350% and the Ectofuntus 400%",
+			"chaos_altar": "Each bone offered at the Wilderness chaos altar has a 50% chance not to be used up",
+			"extra_costs": []string{
+				"Phials unnotes bones for 5 coins each at the gilded altar",
+				"The Elder Chaos druid unnotes bones for 50 coins each",
+				"The Ectofuntus needs a pot and a bucket of slime for every bone",
+				"Offering spells use a wrath rune and a blood or soul rune per three bones",
+			},
+		},
+		"factors_considered": []string{
+			"Bone or ash type (ashes can only be scattered or offered with Demonic Offering)",
+			"XP multiplier and save chance of each method",
+			"Bone, secondary and rune prices",
+			"Bones offered per hour, including banking",
+		},
+		"accuracy_notes": map[string]any{
+			"rates_vary": "Hourly rates depend on how the bones are banked:",
+			"variance_factors": []string{
+				"Chaos altar trips end early when player killers show up",
+				"Gilded altar rates depend on house location and unnoting",
+				"Ectofuntus rates depend on grinding and slime collection",
+			},
+			"calculation_basis": "Rates assume steady banking with no interruptions",
+		},
+		"pro_tips": []map[string]string{
+			{
+				"tip":         "Chaos Altar for Value",
+				"description": "The 50% save chance makes the chaos altar the cheapest per XP if you can avoid player killers",
+			},
+			{
+				"tip":         "Gilded Altar for Safety",
+				"description": "A gilded altar with both burners lit gives 350% XP with no risk",
+			},
+			{
+				"tip":         "Offer While Afk",
+				"description": "Sinister and Demonic Offering give Magic XP too and need no travel to an altar",
+			},
+		},
+		"reward_calculation": map[string]any{
+			"total_cost": "Bones used up × bone price + coins, secondaries and runes",
+			"gp_per_xp":  "Total cost ÷ XP needed",
+			"hours":      "Bones offered ÷ bones offered per hour",
+		},
+	}
+}
@@ -0,0 +1,117 @@
+package prayer
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalculatePrayerData(t *testing.T) {
+	tests := []struct {
+		name         string
+		currentLevel int
+		targetLevel  int
+		bone         string
+		methods      []string
+		expectError  bool
+	}{
+		{
+			name:         "Dragon bones to 70",
+			currentLevel: 43,
+			targetLevel:  70,
+			bone:         "dragon_bones",
+			methods:      []string{"bury", "gilded_altar", "chaos_altar", "ectofuntus", "sinister_offering"},
+		},
+		{
+			name:         "Infernal ashes to 99",
+			currentLevel: 77,
+			targetLevel:  99,
+			bone:         "infernal_ashes",
+			methods:      []string{"bury", "demonic_offering"},
+		},
+		{
+			name:         "Invalid bone",
+			currentLevel: 43,
+			targetLevel:  70,
+			bone:         "goblin_bones",
+			expectError:  true,
+		},
+		{
+			name:         "Target below current",
+			currentLevel: 70,
+			targetLevel:  43,
+			bone:         "dragon_bones",
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculatePrayerData(tt.currentLevel, tt.targetLevel, tt.bone)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(result.Methods) != len(tt.methods) {
+				t.Fatalf("Expected %d methods, got %d", len(tt.methods), len(result.Methods))
+			}
+			for i, method := range result.Methods {
+				if method.Method != tt.methods[i] {
+					t.Errorf("Expected method %s, got %s", tt.methods[i], method.Method)
+				}
+				if float64(method.BonesNeeded)/(1-method.SaveChance)*method.XPPerBone < float64(result.XPNeeded) {
+					t.Errorf("%s: %d bones do not reach %d XP", method.Method, method.BonesNeeded, result.XPNeeded)
+				}
+				if method.TotalCost != method.BoneCost+method.ExtraCost {
+					t.Errorf("%s: expected total cost %d, got %d", method.Method, method.BoneCost+method.ExtraCost, method.TotalCost)
+				}
+			}
+		})
+	}
+}
+
+func TestChaosAltarSavesBones(t *testing.T) {
+	result, err := CalculatePrayerData(43, 99, "dragon_bones")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	bones := make(map[string]int)
+	for _, method := range result.Methods {
+		bones[method.Method] = method.BonesNeeded
+	}
+
+	if ratio := float64(bones["chaos_altar"]) / float64(bones["gilded_altar"]); math.Abs(ratio-0.5) > 0.001 {
+		t.Errorf("Expected the chaos altar to use half the bones of the gilded altar, got ratio %f", ratio)
+	}
+	if bones["ectofuntus"] >= bones["gilded_altar"] {
+		t.Errorf("Expected the Ectofuntus to use fewer bones than the gilded altar")
+	}
+	if result.CheapestPerXP != "chaos_altar" {
+		t.Errorf("Expected the chaos altar to be cheapest per XP, got %s", result.CheapestPerXP)
+	}
+}
+
+func TestCalculatePrayerDataWithPrices(t *testing.T) {
+	livePrices := map[string]int{"Dragon bones": 3000, "Bucket of slime": 1000}
+	result, err := CalculatePrayerDataWithPrices(43, 70, "dragon_bones", livePrices)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result.BonePrice != 3000 {
+		t.Errorf("Expected live bone price 3000, got %d", result.BonePrice)
+	}
+	for _, method := range result.Methods {
+		if method.Method == "ectofuntus" && method.ExtraCost != method.BonesNeeded*(1000+20) {
+			t.Errorf("Expected Ectofuntus extra cost %d, got %d", method.BonesNeeded*1020, method.ExtraCost)
+		}
+	}
+}
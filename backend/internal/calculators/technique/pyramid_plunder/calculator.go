@@ -0,0 +1,47 @@
+package pyramidplunder
+
+import (
+	"osrs-xp-kits/internal/calculators"
+)
+
+// PyramidPlunderInput is the request body accepted by the Pyramid Plunder calculator.
+// Rooms are the rooms looted each run; every room below the highest one is still passed.
+type PyramidPlunderInput struct {
+	CurrentLevel   int   `json:"current_level"`
+	TargetLevel    int   `json:"target_level"`
+	Rooms          []int `json:"rooms,omitempty"`
+	SearchUrns     bool  `json:"search_urns"`
+	SearchChest    bool  `json:"search_chest"`
+	FoodHealAmount int   `json:"food_heal_amount,omitempty"`
+	FoodCost       int   `json:"food_cost,omitempty"`
+}
+
+// Calculator exposes Pyramid Plunder through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the Pyramid Plunder calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "pyramid_plunder",
+		Name:        "Pyramid Plunder",
+		Description: "Thieving XP per room and per hour, artefact loot and Pharaoh's sceptre odds from Pyramid Plunder runs",
+		Category:    "minigame",
+		Skills:      []string{"thieving"},
+	}
+}
+
+// Validate checks the Pyramid Plunder input
+func (Calculator) Validate(input PyramidPlunderInput) error {
+	_, err := validateInput(input)
+	return err
+}
+
+// Calculate runs the Pyramid Plunder calculation
+func (Calculator) Calculate(input PyramidPlunderInput, opts calculators.Options) (PyramidPlunderResult, error) {
+	return CalculatePyramidPlunderDataWithPrices(input, opts.Prices)
+}
+
+// ProTips returns the Pyramid Plunder calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
@@ -0,0 +1,76 @@
+package pyramidplunder
+
+// Room is one room of the pyramid, entered in order from room 1
+type Room struct {
+	Number  int
+	Level   int // Thieving level needed to enter
+	UrnXP   float64
+	ChestXP float64
+	Urns    int // Urns in the room
+	// Artefacts is the tier of artefact found in the room's urns and golden chest
+	Artefacts string
+	// SceptreChance is the chance of a Pharaoh's sceptre from the room's golden chest
+	SceptreChance float64
+}
+
+// Rooms in order, room 1 first
+var Rooms = []Room{
+	{1, 21, 60, 40, 8, "pottery", 1.0 / 3500},
+	{2, 31, 90, 60, 9, "pottery", 1.0 / 2250},
+	{3, 41, 150, 100, 10, "stone", 1.0 / 1500},
+	{4, 51, 215, 140, 11, "stone", 1.0 / 1150},
+	{5, 61, 300, 200, 12, "stone", 1.0 / 950},
+	{6, 71, 450, 300, 12, "gold", 1.0 / 800},
+	{7, 81, 600, 400, 12, "gold", 1.0 / 700},
+	{8, 91, 750, 500, 12, "gold", 1.0 / 650},
+}
+
+// Artefact is an item sold to Simon Templeton
+type Artefact struct {
+	Name  string // Item name, used for live prices
+	Value int    // Default price when live prices are unavailable
+}
+
+// ArtefactTiers keyed by the tier used in Rooms, each artefact equally likely
+var ArtefactTiers = map[string][]Artefact{
+	"pottery": {
+		{"Ivory comb", 50},
+		{"Pottery scarab", 75},
+		{"Pottery statuette", 100},
+	},
+	"stone": {
+		{"Stone seal", 150},
+		{"Stone scarab", 175},
+		{"Stone statuette", 200},
+	},
+	"gold": {
+		{"Gold seal", 750},
+		{"Golden scarab", 1000},
+		{"Golden statuette", 1250},
+	},
+}
+
+// Pyramid Plunder constants based on OSRS Wiki
+const (
+	MinimumThievingLevel = 21
+
+	// Each run lasts until the 5 minute timer runs out
+	TimerSeconds = 300
+	// RunStartSeconds covers talking to the Guardian mummy and walking in
+	RunStartSeconds = 30
+	// RoomSeconds is the time to find the right door and pass the speartrap in each room
+	RoomSeconds  = 15
+	UrnSeconds   = 3
+	ChestSeconds = 3
+
+	// Urn searches can fail and release a snake. The chance to succeed grows with the
+	// levels above the room's requirement.
+	UrnBaseSuccess     = 0.65
+	UrnSuccessPerLevel = 0.01
+	UrnMaxSuccess      = 0.95
+	SnakeBiteDamage    = 5
+
+	// UrnArtefactChance is the chance a successful urn search gives an artefact.
+	// The golden chest always gives one.
+	UrnArtefactChance = 0.35
+)
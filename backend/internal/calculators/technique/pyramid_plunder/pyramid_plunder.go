@@ -0,0 +1,325 @@
+package pyramidplunder
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"osrs-xp-kits/internal/calculators/probability"
+	"osrs-xp-kits/internal/calculators/progression"
+	"osrs-xp-kits/internal/calculators/tools"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+// PyramidPlunderResult mirrors ArdyKnightResult with a run as the attempt, so the two
+// thieving methods can be compared field by field. The run-specific fields follow.
+type PyramidPlunderResult struct {
+	CalculatedSuccessRate float64 `json:"calculated_success_rate"` // Urn search success, weighted by XP
+	EffectiveXPPerAttempt float64 `json:"effective_xp_per_attempt"`
+	EffectiveGPPerAttempt float64 `json:"effective_gp_per_attempt"`
+	XPHour                int     `json:"xp_hour"`
+	GPHour                int     `json:"gp_hour"`
+	DamagePerHour         int     `json:"damage_per_hour"`
+	FoodNeededPerHour     int     `json:"food_needed_per_hour"`
+	ProfitPerHour         int     `json:"profit_per_hour"`
+
+	CurrentThievingLevel int     `json:"current_thieving_level"`
+	TargetThievingLevel  int     `json:"target_thieving_level"`
+	CurrentTotalXP       int     `json:"current_total_xp"`
+	TargetTotalXP        int     `json:"target_total_xp"`
+	XPToTarget           int     `json:"xp_to_target"`
+	HoursToTarget        float64 `json:"hours_to_target"`
+	AttemptsToTarget     int     `json:"attempts_to_target"`
+
+	Progression progression.Progression `json:"progression"`
+
+	RunSeconds          float64                `json:"run_seconds"`
+	RunsPerHour         float64                `json:"runs_per_hour"`
+	RunsToTarget        int                    `json:"runs_to_target"`
+	Rooms               []RoomStats            `json:"rooms"`
+	SceptreChancePerRun float64                `json:"sceptre_chance_per_run"`
+	UniqueOdds          []probability.DropOdds `json:"unique_odds"`
+}
+
+// RoomStats is what one looted room gives per run at the current level
+type RoomStats struct {
+	Room             int     `json:"room"`
+	Level            int     `json:"level"`
+	UrnsSearched     int     `json:"urns_searched"`
+	ChestSearched    bool    `json:"chest_searched"`
+	UrnSuccessRate   float64 `json:"urn_success_rate"`
+	XPPerRun         float64 `json:"xp_per_run"`
+	ArtefactsPerRun  float64 `json:"artefacts_per_run"`
+	ArtefactGPPerRun float64 `json:"artefact_gp_per_run"`
+	FailedUrnsPerRun float64 `json:"failed_urns_per_run"`
+	SceptreChance    float64 `json:"sceptre_chance"`
+	maxXPPerRun      float64
+}
+
+// UniqueDropOdds returns the odds of getting the Pharaoh's sceptre
+func (r PyramidPlunderResult) UniqueDropOdds() []probability.DropOdds {
+	return r.UniqueOdds
+}
+
+func CalculatePyramidPlunderData(input PyramidPlunderInput) (PyramidPlunderResult, error) {
+	return CalculatePyramidPlunderDataWithPrices(input, nil)
+}
+
+// CalculatePyramidPlunderDataWithPrices calculates Pyramid Plunder data with optional live artefact prices
+func CalculatePyramidPlunderDataWithPrices(input PyramidPlunderInput, livePrices map[string]int) (PyramidPlunderResult, error) {
+	rooms, err := validateInput(input)
+	if err != nil {
+		return PyramidPlunderResult{}, err
+	}
+
+	// Every room up to the highest looted one has to be passed, looted or not
+	lootSeconds := float64(rooms[len(rooms)-1].Number * RoomSeconds)
+	for _, room := range rooms {
+		if input.SearchUrns {
+			lootSeconds += float64(room.Urns * UrnSeconds)
+		}
+		if input.SearchChest {
+			lootSeconds += ChestSeconds
+		}
+	}
+	if lootSeconds > TimerSeconds {
+		return PyramidPlunderResult{}, fmt.Errorf("looting the chosen rooms takes %.0f seconds, longer than the %d second timer", lootSeconds, TimerSeconds)
+	}
+	runSeconds := RunStartSeconds + lootSeconds
+	runsPerHour := 3600 / runSeconds
+
+	roomStats := calculateRoomStats(input, rooms, input.CurrentLevel, livePrices)
+
+	var xpPerRun, maxXPPerRun, gpPerRun, failedUrnsPerRun float64
+	noSceptreChance := 1.0
+	for _, stats := range roomStats {
+		xpPerRun += stats.XPPerRun
+		maxXPPerRun += stats.maxXPPerRun
+		gpPerRun += stats.ArtefactGPPerRun
+		failedUrnsPerRun += stats.FailedUrnsPerRun
+		noSceptreChance *= 1 - stats.SceptreChance
+	}
+	sceptreChance := 1 - noSceptreChance
+
+	damagePerHour := int(math.Round(failedUrnsPerRun * SnakeBiteDamage * runsPerHour))
+	foodNeededPerHour := 0
+	if input.FoodHealAmount > 0 {
+		foodNeededPerHour = int(math.Ceil(float64(damagePerHour) / float64(input.FoodHealAmount)))
+	}
+	gpPerHour := int(math.Round(gpPerRun * runsPerHour))
+
+	// Urn searches fail less as the level rises, so each level uses its own run XP.
+	// The run is the action; its success chance is the share of the full XP actually gained.
+	currentXP := xp.ForLevel(input.CurrentLevel)
+	targetXP := xp.ForLevel(input.TargetLevel)
+	levelProgression, err := progression.Walk(currentXP, targetXP, func(level int) progression.Rate {
+		var expected, full float64
+		for _, stats := range calculateRoomStats(input, rooms, level, nil) {
+			expected += stats.XPPerRun
+			full += stats.maxXPPerRun
+		}
+		return progression.Rate{
+			XPPerAction:    full,
+			ActionsPerHour: runsPerHour,
+			SuccessChance:  expected / full,
+		}
+	})
+	if err != nil {
+		return PyramidPlunderResult{}, err
+	}
+	runsToTarget := int(math.Ceil(levelProgression.TotalActions))
+
+	uniqueOdds := []probability.DropOdds{}
+	if sceptreChance > 0 {
+		uniqueOdds, err = probability.UniqueOdds([]probability.Unique{{Name: "Pharaoh's sceptre", Rate: sceptreChance}}, runsToTarget)
+		if err != nil {
+			return PyramidPlunderResult{}, err
+		}
+	}
+
+	return PyramidPlunderResult{
+		CalculatedSuccessRate: xpPerRun / maxXPPerRun,
+		EffectiveXPPerAttempt: xpPerRun,
+		EffectiveGPPerAttempt: gpPerRun,
+		XPHour:                int(math.Round(xpPerRun * runsPerHour)),
+		GPHour:                gpPerHour,
+		DamagePerHour:         damagePerHour,
+		FoodNeededPerHour:     foodNeededPerHour,
+		ProfitPerHour:         gpPerHour - foodNeededPerHour*input.FoodCost,
+
+		CurrentThievingLevel: input.CurrentLevel,
+		TargetThievingLevel:  input.TargetLevel,
+		CurrentTotalXP:       currentXP,
+		TargetTotalXP:        targetXP,
+		XPToTarget:           targetXP - currentXP,
+		HoursToTarget:        levelProgression.TotalHours,
+		AttemptsToTarget:     runsToTarget,
+
+		Progression: levelProgression,
+
+		RunSeconds:          runSeconds,
+		RunsPerHour:         runsPerHour,
+		RunsToTarget:        runsToTarget,
+		Rooms:               roomStats,
+		SceptreChancePerRun: sceptreChance,
+		UniqueOdds:          uniqueOdds,
+	}, nil
+}
+
+// calculateRoomStats returns the XP, artefacts and failed searches of each looted room at a level
+func calculateRoomStats(input PyramidPlunderInput, rooms []Room, level int, livePrices map[string]int) []RoomStats {
+	stats := make([]RoomStats, 0, len(rooms))
+	for _, room := range rooms {
+		urns := 0
+		if input.SearchUrns {
+			urns = room.Urns
+		}
+		success := urnSuccessChance(level, room)
+		successfulUrns := float64(urns) * success
+
+		roomStats := RoomStats{
+			Room:             room.Number,
+			Level:            room.Level,
+			UrnsSearched:     urns,
+			ChestSearched:    input.SearchChest,
+			UrnSuccessRate:   success,
+			XPPerRun:         successfulUrns * room.UrnXP,
+			ArtefactsPerRun:  successfulUrns * UrnArtefactChance,
+			FailedUrnsPerRun: float64(urns) - successfulUrns,
+			maxXPPerRun:      float64(urns) * room.UrnXP,
+		}
+		if input.SearchChest {
+			roomStats.XPPerRun += room.ChestXP
+			roomStats.maxXPPerRun += room.ChestXP
+			roomStats.ArtefactsPerRun++
+			roomStats.SceptreChance = room.SceptreChance
+		}
+		roomStats.ArtefactGPPerRun = roomStats.ArtefactsPerRun * averageArtefactValue(room.Artefacts, livePrices)
+
+		stats = append(stats, roomStats)
+	}
+	return stats
+}
+
+// urnSuccessChance returns the chance an urn search in the room succeeds at a level
+func urnSuccessChance(level int, room Room) float64 {
+	return math.Min(UrnMaxSuccess, UrnBaseSuccess+UrnSuccessPerLevel*float64(level-room.Level))
+}
+
+// averageArtefactValue returns the average price of an artefact from the tier
+func averageArtefactValue(tier string, livePrices map[string]int) float64 {
+	artefacts := ArtefactTiers[tier]
+	total := 0
+	for _, artefact := range artefacts {
+		total += tools.PriceOr(artefact.Name, artefact.Value, livePrices)
+	}
+	return float64(total) / float64(len(artefacts))
+}
+
+// validateInput checks the input and returns the looted rooms in order.
+// No rooms means the highest room the player can enter.
+func validateInput(input PyramidPlunderInput) ([]Room, error) {
+	if input.CurrentLevel < MinimumThievingLevel {
+		return nil, fmt.Errorf("Pyramid Plunder requires %d Thieving", MinimumThievingLevel)
+	}
+	if err := xp.ValidateLevel(input.CurrentLevel); err != nil {
+		return nil, fmt.Errorf("invalid current thieving level: %w", err)
+	}
+	if input.TargetLevel < input.CurrentLevel {
+		return nil, fmt.Errorf("target level must be greater than or equal to current level")
+	}
+	if input.TargetLevel > xp.MaxVirtualLevel {
+		return nil, fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+	if !input.SearchUrns && !input.SearchChest {
+		return nil, fmt.Errorf("at least one of search_urns or search_chest must be set")
+	}
+	if input.FoodHealAmount < 0 || input.FoodCost < 0 {
+		return nil, fmt.Errorf("food heal amount and cost cannot be negative")
+	}
+
+	numbers := slices.Clone(input.Rooms)
+	if len(numbers) == 0 {
+		for _, room := range Rooms {
+			if room.Level <= input.CurrentLevel {
+				numbers = []int{room.Number}
+			}
+		}
+	}
+	slices.Sort(numbers)
+
+	rooms := make([]Room, 0, len(numbers))
+	for i, number := range numbers {
+		if number < 1 || number > len(Rooms) {
+			return nil, fmt.Errorf("invalid room: %d", number)
+		}
+		if i > 0 && numbers[i-1] == number {
+			return nil, fmt.Errorf("duplicate room: %d", number)
+		}
+		room := Rooms[number-1]
+		if input.CurrentLevel < room.Level {
+			return nil, fmt.Errorf("room %d requires %d Thieving", room.Number, room.Level)
+		}
+		rooms = append(rooms, room)
+	}
+	return rooms, nil
+}
+
+// GetCalculationProTips provides detailed information about how Pyramid Plunder calculations work
+func GetCalculationProTips() map[string]any {
+	return map[string]any{
+		"calculation_methodology": map[string]any{
+			"xp_rates_source": "Urn and golden chest XP from the OSRS Wiki, search times from community testing",
+			"base_formula":    "XP per run = Σ (urns × success rate × urn XP + chest XP) over the looted rooms, × runs per hour",
+			"data_points": []map[string]any{
+				{"level": 21, "rooms": "1", "xp_per_hour": 17600, "note": "Minimum access level"},
+				{"level": 61, "rooms": "5", "xp_per_hour": 63500, "note": "Stone artefacts"},
+				{"level": 91, "rooms": "5-8", "xp_per_hour": 243000, "note": "All rooms unlocked"},
+				{"level": 99, "rooms": "5-8", "xp_per_hour": 263000, "note": "Maximum urn success"},
+			},
+		},
+		"game_mechanics": map[string]any{
+			"timer":   "Each run lasts at most 5 minutes, starting in room 1",
+			"rooms":   "Rooms 1-8 need 21, 31, 41, 51, 61, 71, 81 and 91 Thieving",
+			"urns":    "Urn searches can fail and release a snake, less often the higher the level above the room",
+			"sceptre": "The golden chest of every room can hold the Pharaoh's sceptre, more often in higher rooms",
+		},
+		"factors_considered": []string{
+			"Thieving level (affects urn success and rooms reachable)",
+			"Rooms looted and rooms passed on the way",
+			"Urn and golden chest searches",
+			"Artefact prices",
+			"Snake bite damage and food",
+		},
+		"accuracy_notes": map[string]any{
+			"rates_vary": "Run times depend on the player:",
+			"variance_factors": []string{
+				"Finding the right door in each room",
+				"Speartrap and snake interruptions",
+				"Scarab swarms from the golden chest",
+				"Travel between runs",
+			},
+			"calculation_basis": "The same rooms are looted every run, chosen at the current level",
+		},
+		"pro_tips": []map[string]string{
+			{
+				"tip":         "Loot the Top Rooms",
+				"description": "At 91 Thieving, looting rooms 5-8 fills the timer with the highest XP urns",
+			},
+			{
+				"tip":         "Always Open the Chest",
+				"description": "Golden chests are quick, always give an artefact and are the only source of the sceptre",
+			},
+			{
+				"tip":         "Sell to Simon",
+				"description": "Simon Templeton buys artefacts, often for more than the Grand Exchange",
+			},
+		},
+		"reward_calculation": map[string]any{
+			"artefacts":       "Successful urns give an artefact 35% of the time, golden chests always do",
+			"artefact_value":  "Average price of the room's artefact tier: pottery, stone or gold",
+			"sceptre_chance":  "1 - Π (1 - chest chance) over the rooms whose chest is searched",
+			"profit_per_hour": "Artefact value minus food for snake bites",
+		},
+	}
+}
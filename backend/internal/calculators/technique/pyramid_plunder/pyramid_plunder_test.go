@@ -0,0 +1,139 @@
+package pyramidplunder
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalculatePyramidPlunderData(t *testing.T) {
+	tests := []struct {
+		name              string
+		input             PyramidPlunderInput
+		expectError       bool
+		expectedMinXPHour int
+		expectedMaxXPHour int
+	}{
+		{
+			name:              "Room 1 at level 21",
+			input:             PyramidPlunderInput{CurrentLevel: 21, TargetLevel: 31, SearchUrns: true, SearchChest: true},
+			expectedMinXPHour: 15000,
+			expectedMaxXPHour: 20000,
+		},
+		{
+			name:              "Rooms 5 to 8 at level 91",
+			input:             PyramidPlunderInput{CurrentLevel: 91, TargetLevel: 99, Rooms: []int{5, 6, 7, 8}, SearchUrns: true, SearchChest: true, FoodHealAmount: 20, FoodCost: 200},
+			expectedMinXPHour: 230000,
+			expectedMaxXPHour: 255000,
+		},
+		{
+			name:              "Chests only",
+			input:             PyramidPlunderInput{CurrentLevel: 81, TargetLevel: 85, Rooms: []int{6, 7}, SearchChest: true},
+			expectedMinXPHour: 1,
+			expectedMaxXPHour: 100000,
+		},
+		{
+			name:        "Level too low",
+			input:       PyramidPlunderInput{CurrentLevel: 20, TargetLevel: 30, SearchUrns: true},
+			expectError: true,
+		},
+		{
+			name:        "Room not reachable",
+			input:       PyramidPlunderInput{CurrentLevel: 60, TargetLevel: 70, Rooms: []int{6}, SearchUrns: true},
+			expectError: true,
+		},
+		{
+			name:        "Nothing searched",
+			input:       PyramidPlunderInput{CurrentLevel: 60, TargetLevel: 70},
+			expectError: true,
+		},
+		{
+			name:        "Rooms do not fit in the timer",
+			input:       PyramidPlunderInput{CurrentLevel: 99, TargetLevel: 99, Rooms: []int{1, 2, 3, 4, 5, 6, 7, 8}, SearchUrns: true, SearchChest: true},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculatePyramidPlunderData(tt.input)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result.XPHour < tt.expectedMinXPHour || result.XPHour > tt.expectedMaxXPHour {
+				t.Errorf("XP per hour out of range: got %d, want between %d and %d",
+					result.XPHour, tt.expectedMinXPHour, tt.expectedMaxXPHour)
+			}
+			if result.RunSeconds > RunStartSeconds+TimerSeconds {
+				t.Errorf("Run of %.0f seconds should fit in the timer", result.RunSeconds)
+			}
+			if result.ProfitPerHour > result.GPHour {
+				t.Errorf("Profit %d should not exceed GP %d", result.ProfitPerHour, result.GPHour)
+			}
+		})
+	}
+}
+
+func TestDefaultRoomIsHighestReachable(t *testing.T) {
+	result, err := CalculatePyramidPlunderData(PyramidPlunderInput{CurrentLevel: 75, TargetLevel: 80, SearchUrns: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(result.Rooms) != 1 || result.Rooms[0].Room != 6 {
+		t.Errorf("Expected room 6 only, got %+v", result.Rooms)
+	}
+	if len(result.UniqueOdds) != 0 {
+		t.Errorf("Expected no sceptre odds without chests, got %d", len(result.UniqueOdds))
+	}
+}
+
+func TestSceptreOdds(t *testing.T) {
+	result, err := CalculatePyramidPlunderData(PyramidPlunderInput{CurrentLevel: 91, TargetLevel: 99, Rooms: []int{7, 8}, SearchChest: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := 1 - (1-Rooms[6].SceptreChance)*(1-Rooms[7].SceptreChance)
+	if math.Abs(result.SceptreChancePerRun-expected) > 1e-12 {
+		t.Errorf("Expected sceptre chance %f, got %f", expected, result.SceptreChancePerRun)
+	}
+
+	if len(result.UniqueOdds) != 1 {
+		t.Fatalf("Expected sceptre odds, got %d entries", len(result.UniqueOdds))
+	}
+	if result.AttemptsToTarget != result.RunsToTarget {
+		t.Errorf("Attempts to target should be the %d runs to target, got %d", result.RunsToTarget, result.AttemptsToTarget)
+	}
+	odds := result.UniqueOdds[0]
+	if odds.Attempts != result.RunsToTarget {
+		t.Errorf("Sceptre odds should cover the %d runs to target, got %d", result.RunsToTarget, odds.Attempts)
+	}
+	if odds.DryStreakPercentile <= 0 || odds.DryStreakPercentile >= 100 {
+		t.Errorf("Dry streak percentile out of range: %f", odds.DryStreakPercentile)
+	}
+}
+
+func TestUrnSuccessImprovesWithLevel(t *testing.T) {
+	result, err := CalculatePyramidPlunderData(PyramidPlunderInput{CurrentLevel: 71, TargetLevel: 99, Rooms: []int{6}, SearchUrns: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	segments := result.Progression.Segments
+	if segments[0].SuccessChance >= segments[len(segments)-1].SuccessChance {
+		t.Errorf("Urn success should improve with level: %.2f to %.2f", segments[0].SuccessChance, segments[len(segments)-1].SuccessChance)
+	}
+
+	startingRateHours := float64(result.XPToTarget) / float64(result.XPHour)
+	if result.HoursToTarget >= startingRateHours {
+		t.Errorf("Level-by-level hours %.1f should be below the starting-rate estimate %.1f", result.HoursToTarget, startingRateHours)
+	}
+}
@@ -0,0 +1,65 @@
+package rooftops
+
+import (
+	"fmt"
+
+	"osrs-xp-kits/internal/calculators"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+// RooftopInput is the request body accepted by the rooftop agility calculator
+type RooftopInput struct {
+	CurrentLevel  int    `json:"current_level"`
+	TargetLevel   int    `json:"target_level"`
+	Goal          string `json:"goal"`
+	ArdougneDiary bool   `json:"ardougne_diary"`
+	BuyGraceful   bool   `json:"buy_graceful"`
+}
+
+// Calculator exposes rooftop agility through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the rooftop agility calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "rooftops",
+		Name:        "Rooftop Agility",
+		Description: "Best rooftop course per level, laps, hours and marks of grace spent on Graceful or amylase crystals",
+		Category:    "skilling",
+		Skills:      []string{"agility"},
+	}
+}
+
+// Validate checks the rooftop agility input
+func (Calculator) Validate(input RooftopInput) error {
+	if input.CurrentLevel < MinimumAgilityLevel {
+		return fmt.Errorf("agility level must be at least %d", MinimumAgilityLevel)
+	}
+	if input.TargetLevel < input.CurrentLevel {
+		return fmt.Errorf("target level must be greater than or equal to current level")
+	}
+	if input.TargetLevel > xp.MaxVirtualLevel {
+		return fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+	if Goal(input.Goal) != GoalXP && Goal(input.Goal) != GoalMarks {
+		return fmt.Errorf("invalid goal: %s", input.Goal)
+	}
+	return nil
+}
+
+// Calculate runs the rooftop agility calculation
+func (Calculator) Calculate(input RooftopInput, opts calculators.Options) (RooftopResult, error) {
+	return CalculateRooftopDataWithPrices(
+		input.CurrentLevel,
+		input.TargetLevel,
+		Goal(input.Goal),
+		input.ArdougneDiary,
+		input.BuyGraceful,
+		opts.Prices,
+	)
+}
+
+// ProTips returns the rooftop agility calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
@@ -0,0 +1,82 @@
+package rooftops
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"osrs-xp-kits/internal/calculators/skills"
+)
+
+// Course is a rooftop agility course, read from the agility skill data
+type Course struct {
+	ID           string  `json:"id"`
+	Name         string  `json:"name"`
+	Level        int     `json:"level"`
+	XPPerLap     float64 `json:"xp_per_lap"`
+	XPPerHour    float64 `json:"xp_per_hour"`
+	MarksPerHour float64 `json:"marks_per_hour"`
+	// DiaryBoost is set for courses whose marks are boosted by the Ardougne elite diary
+	DiaryBoost bool `json:"diary_boost"`
+}
+
+// Rooftop and mark constants based on OSRS Wiki
+const (
+	// MinimumAgilityLevel is the level of the first course that awards marks of grace
+	MinimumAgilityLevel = 10
+
+	// ArdougneDiaryMarkBonus multiplies marks on boosted courses once the Ardougne elite diary is done
+	ArdougneDiaryMarkBonus = 1.25
+
+	// AmylasePerMark is the amylase crystals bought with one mark of grace
+	AmylasePerMark = 10
+
+	// DefaultAmylasePrice is used when live prices are unavailable
+	DefaultAmylasePrice = 190
+
+	rooftopTag    = "rooftop"
+	diaryBoostTag = "diary_boost_available"
+)
+
+// GracefulPiece is a piece of the Graceful outfit bought with marks of grace
+type GracefulPiece struct {
+	Name string `json:"name"`
+	Cost int    `json:"cost"`
+}
+
+// GracefulOutfit in the order usually bought, the full set costs 260 marks
+var GracefulOutfit = []GracefulPiece{
+	{"Graceful hood", 35},
+	{"Graceful cape", 40},
+	{"Graceful top", 55},
+	{"Graceful legs", 60},
+	{"Graceful gloves", 30},
+	{"Graceful boots", 40},
+}
+
+// Courses returns every rooftop course that awards marks of grace, ordered by level
+var Courses = sync.OnceValues(func() ([]Course, error) {
+	agility, err := skills.Load("agility")
+	if err != nil {
+		return nil, err
+	}
+
+	var courses []Course
+	for _, method := range agility.MethodsWithTag(rooftopTag) {
+		if method.MarksPerHour == nil || method.XPPerAction == nil || *method.XPPerAction <= 0 {
+			return nil, fmt.Errorf("rooftop course %s is missing marks or XP per lap", method.ID)
+		}
+		courses = append(courses, Course{
+			ID:           method.ID,
+			Name:         method.Name,
+			Level:        method.LevelReq,
+			XPPerLap:     *method.XPPerAction,
+			XPPerHour:    method.XPRate,
+			MarksPerHour: *method.MarksPerHour,
+			DiaryBoost:   slices.Contains(method.Tags, diaryBoostTag),
+		})
+	}
+
+	slices.SortStableFunc(courses, func(a, b Course) int { return a.Level - b.Level })
+	return courses, nil
+})
@@ -0,0 +1,300 @@
+package rooftops
+
+import (
+	"fmt"
+	"math"
+
+	"osrs-xp-kits/internal/calculators/progression"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+// Goal decides which course is best at each level
+type Goal string
+
+const (
+	GoalXP    Goal = "xp"
+	GoalMarks Goal = "marks"
+)
+
+type RooftopResult struct {
+	CurrentLevel     int             `json:"current_level"`
+	TargetLevel      int             `json:"target_level"`
+	XPNeeded         int             `json:"xp_needed"`
+	Goal             string          `json:"goal"`
+	ArdougneDiary    bool            `json:"ardougne_diary"`
+	Segments         []CourseSegment `json:"segments"`
+	TotalLaps        int             `json:"total_laps"`
+	HoursNeeded      float64         `json:"hours_needed"`
+	AverageXPPerHour float64         `json:"average_xp_per_hour"`
+	MarksOfGrace     int             `json:"marks_of_grace"`
+	MarksPerHour     float64         `json:"marks_per_hour"`
+
+	Graceful        []GracefulProgress `json:"graceful"`
+	GracefulMarks   int                `json:"graceful_marks"`
+	AmylaseMarks    int                `json:"amylase_marks"`
+	AmylaseCrystals int                `json:"amylase_crystals"`
+	AmylasePrice    int                `json:"amylase_price"`
+	TotalValue      int                `json:"total_value"`
+	GPPerHour       float64            `json:"gp_per_hour"`
+
+	Progression progression.Progression `json:"progression"`
+}
+
+// CourseSegment is a run of levels trained on the same course
+type CourseSegment struct {
+	Course       Course  `json:"course"`
+	StartLevel   int     `json:"start_level"`
+	EndLevel     int     `json:"end_level"`
+	XP           int     `json:"xp"`
+	Laps         int     `json:"laps"`
+	Hours        float64 `json:"hours"`
+	MarksPerHour float64 `json:"marks_per_hour"`
+	Marks        float64 `json:"marks"`
+}
+
+// GracefulProgress tracks when a Graceful piece can be bought, buying pieces in order
+type GracefulProgress struct {
+	GracefulPiece
+	CumulativeCost int     `json:"cumulative_cost"`
+	HoursToAfford  float64 `json:"hours_to_afford"`
+	Affordable     bool    `json:"affordable"`
+}
+
+func CalculateRooftopData(currentLevel, targetLevel int, goal Goal, ardougneDiary, buyGraceful bool) (RooftopResult, error) {
+	return CalculateRooftopDataWithPrices(currentLevel, targetLevel, goal, ardougneDiary, buyGraceful, nil)
+}
+
+// CalculateRooftopDataWithPrices calculates rooftop agility data with an optional live amylase crystal price
+func CalculateRooftopDataWithPrices(currentLevel, targetLevel int, goal Goal, ardougneDiary, buyGraceful bool, livePrices map[string]int) (RooftopResult, error) {
+	if currentLevel < MinimumAgilityLevel {
+		return RooftopResult{}, fmt.Errorf("agility level must be at least %d", MinimumAgilityLevel)
+	}
+
+	if targetLevel < currentLevel {
+		return RooftopResult{}, fmt.Errorf("target level must be greater than or equal to current level")
+	}
+
+	if targetLevel > xp.MaxVirtualLevel {
+		return RooftopResult{}, fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+
+	if goal != GoalXP && goal != GoalMarks {
+		return RooftopResult{}, fmt.Errorf("invalid goal: %s", goal)
+	}
+
+	courses, err := Courses()
+	if err != nil {
+		return RooftopResult{}, err
+	}
+
+	best := func(level int) Course {
+		course, _ := BestCourse(courses, min(level, xp.MaxLevel), goal, ardougneDiary)
+		return course
+	}
+
+	// Walk level by level, switching to a better course as soon as it unlocks
+	currentXP := xp.ForLevel(currentLevel)
+	targetXP := xp.ForLevel(targetLevel)
+	levelProgression, err := progression.Walk(currentXP, targetXP, func(level int) progression.Rate {
+		course := best(level)
+		return progression.Rate{
+			XPPerAction:    course.XPPerLap,
+			ActionsPerHour: course.XPPerHour / course.XPPerLap,
+			SuccessChance:  1,
+		}
+	})
+	if err != nil {
+		return RooftopResult{}, err
+	}
+
+	segments := courseSegments(levelProgression, best, ardougneDiary)
+
+	totalLaps, totalMarks := 0, 0.0
+	for _, segment := range segments {
+		totalLaps += segment.Laps
+		totalMarks += segment.Marks
+	}
+	marksOfGrace := int(math.Floor(totalMarks))
+
+	hoursNeeded := levelProgression.TotalHours
+	marksPerHour := marksPerHourOn(best(currentLevel), ardougneDiary)
+	if hoursNeeded > 0 {
+		marksPerHour = totalMarks / hoursNeeded
+	}
+
+	// Marks go toward the Graceful outfit first when wanted, the rest become amylase crystals
+	gracefulMarks := 0
+	if buyGraceful {
+		gracefulMarks = min(marksOfGrace, gracefulCost())
+	}
+	amylaseMarks := marksOfGrace - gracefulMarks
+	amylaseCrystals := amylaseMarks * AmylasePerMark
+
+	amylasePrice := DefaultAmylasePrice
+	if livePrice, exists := livePrices["Amylase crystal"]; exists {
+		amylasePrice = livePrice
+	}
+	totalValue := amylaseCrystals * amylasePrice
+
+	gpPerHour := 0.0
+	if hoursNeeded > 0 {
+		gpPerHour = float64(totalValue) / hoursNeeded
+	}
+
+	return RooftopResult{
+		CurrentLevel:     currentLevel,
+		TargetLevel:      targetLevel,
+		XPNeeded:         targetXP - currentXP,
+		Goal:             string(goal),
+		ArdougneDiary:    ardougneDiary,
+		Segments:         segments,
+		TotalLaps:        totalLaps,
+		HoursNeeded:      hoursNeeded,
+		AverageXPPerHour: levelProgression.AverageXPPerHour,
+		MarksOfGrace:     marksOfGrace,
+		MarksPerHour:     marksPerHour,
+		Graceful:         gracefulProgress(marksOfGrace, marksPerHour),
+		GracefulMarks:    gracefulMarks,
+		AmylaseMarks:     amylaseMarks,
+		AmylaseCrystals:  amylaseCrystals,
+		AmylasePrice:     amylasePrice,
+		TotalValue:       totalValue,
+		GPPerHour:        gpPerHour,
+		Progression:      levelProgression,
+	}, nil
+}
+
+// BestCourse returns the unlocked course with the most XP or marks per hour at a level
+func BestCourse(courses []Course, level int, goal Goal, ardougneDiary bool) (Course, bool) {
+	var best Course
+	found := false
+	for _, course := range courses {
+		if level < course.Level {
+			continue
+		}
+		if !found || score(course, goal, ardougneDiary) > score(best, goal, ardougneDiary) {
+			best, found = course, true
+		}
+	}
+	return best, found
+}
+
+func score(course Course, goal Goal, ardougneDiary bool) float64 {
+	if goal == GoalMarks {
+		return marksPerHourOn(course, ardougneDiary)
+	}
+	return course.XPPerHour
+}
+
+// marksPerHourOn returns the marks of grace per hour on a course, including the diary bonus
+func marksPerHourOn(course Course, ardougneDiary bool) float64 {
+	if ardougneDiary && course.DiaryBoost {
+		return course.MarksPerHour * ArdougneDiaryMarkBonus
+	}
+	return course.MarksPerHour
+}
+
+// courseSegments groups consecutive levels trained on the same course
+func courseSegments(levelProgression progression.Progression, best func(level int) Course, ardougneDiary bool) []CourseSegment {
+	var segments []CourseSegment
+	for _, segment := range levelProgression.Segments {
+		course := best(segment.Level)
+		if len(segments) == 0 || segments[len(segments)-1].Course.ID != course.ID {
+			segments = append(segments, CourseSegment{
+				Course:       course,
+				StartLevel:   segment.Level,
+				MarksPerHour: marksPerHourOn(course, ardougneDiary),
+			})
+		}
+
+		current := &segments[len(segments)-1]
+		current.EndLevel = segment.Level + 1
+		current.XP += segment.XP
+		current.Hours += segment.Hours
+		current.Marks += segment.Hours * current.MarksPerHour
+	}
+
+	for i := range segments {
+		segments[i].Laps = int(math.Ceil(float64(segments[i].XP) / segments[i].Course.XPPerLap))
+	}
+	return segments
+}
+
+// gracefulCost returns the marks needed for the full Graceful outfit
+func gracefulCost() int {
+	total := 0
+	for _, piece := range GracefulOutfit {
+		total += piece.Cost
+	}
+	return total
+}
+
+// gracefulProgress returns when each Graceful piece is affordable, buying them in order
+func gracefulProgress(marks int, marksPerHour float64) []GracefulProgress {
+	progress := make([]GracefulProgress, 0, len(GracefulOutfit))
+	cumulative := 0
+	for _, piece := range GracefulOutfit {
+		cumulative += piece.Cost
+		progress = append(progress, GracefulProgress{
+			GracefulPiece:  piece,
+			CumulativeCost: cumulative,
+			HoursToAfford:  float64(cumulative) / marksPerHour,
+			Affordable:     marks >= cumulative,
+		})
+	}
+	return progress
+}
+
+// GetCalculationProTips provides detailed information about how rooftop agility calculations work
+func GetCalculationProTips() map[string]any {
+	return map[string]any{
+		"calculation_methodology": map[string]any{
+			"xp_rates_source": "Course XP and mark rates from the agility skill data",
+			"base_formula":    "Laps = XP needed on each course ÷ XP per lap, using the best unlocked course level by level",
+			"data_points": []map[string]any{
+				{"level": 40, "course": "Canifis", "xp_per_hour": 16000, "marks_per_hour": 18},
+				{"level": 60, "course": "Seers' Village", "xp_per_hour": 45000, "marks_per_hour": 16},
+				{"level": 90, "course": "Ardougne", "xp_per_hour": 61000, "marks_per_hour": 25, "note": "With the elite diary"},
+			},
+		},
+		"game_mechanics": map[string]any{
+			"marks_of_grace": "Marks spawn less often once you are 20 or more levels above a course",
+			"graceful":       "The full Graceful outfit costs 260 marks and reduces weight and restores run energy faster",
+			"amylase":        "Each mark buys 10 amylase crystals, used to make stamina potions",
+		},
+		"factors_considered": []string{
+			"Agility level (affects the courses unlocked)",
+			"Goal of most XP or most marks per hour",
+			"Ardougne elite diary bonus on the Ardougne course",
+			"Marks spent on Graceful before amylase crystals",
+		},
+		"accuracy_notes": map[string]any{
+			"rates_vary": "Individual rates can vary ±15% based on:",
+			"variance_factors": []string{
+				"Failed obstacles on the harder courses",
+				"Click efficiency and lap times",
+				"Competing for marks on busy worlds",
+			},
+			"calculation_basis": "Rates assume steady laps without breaks",
+		},
+		"pro_tips": []map[string]string{
+			{
+				"tip":         "Graceful First",
+				"description": "Buy the Graceful outfit before selling marks, the run energy saved pays off on every later course",
+			},
+			{
+				"tip":         "Canifis for Marks",
+				"description": "Canifis gives the most marks of grace until Ardougne, even though Seers' Village gives more XP",
+			},
+			{
+				"tip":         "Seers' Teleport",
+				"description": "The Kandarin hard diary lets Camelot teleport land next to the course start",
+			},
+		},
+		"reward_calculation": map[string]any{
+			"marks":       "Course marks per hour × hours spent on the course",
+			"amylase":     "Marks left after Graceful × 10 amylase crystals",
+			"gp_per_hour": "Amylase crystal value at live price averaged over the whole grind",
+		},
+	}
+}
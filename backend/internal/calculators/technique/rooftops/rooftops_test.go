@@ -0,0 +1,138 @@
+package rooftops
+
+import "testing"
+
+func TestCalculateRooftopData(t *testing.T) {
+	tests := []struct {
+		name         string
+		currentLevel int
+		targetLevel  int
+		goal         Goal
+		expectError  bool
+	}{
+		{
+			name:         "Level 10 to 50 for XP",
+			currentLevel: 10,
+			targetLevel:  50,
+			goal:         GoalXP,
+		},
+		{
+			name:         "Level 60 to 99 for marks",
+			currentLevel: 60,
+			targetLevel:  99,
+			goal:         GoalMarks,
+		},
+		{
+			name:         "Invalid level (too low)",
+			currentLevel: 9,
+			targetLevel:  30,
+			goal:         GoalXP,
+			expectError:  true,
+		},
+		{
+			name:         "Target level lower than current",
+			currentLevel: 70,
+			targetLevel:  60,
+			goal:         GoalXP,
+			expectError:  true,
+		},
+		{
+			name:         "Invalid goal",
+			currentLevel: 50,
+			targetLevel:  60,
+			goal:         "pets",
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculateRooftopData(tt.currentLevel, tt.targetLevel, tt.goal, false, false)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(result.Segments) == 0 {
+				t.Fatalf("Expected at least one course segment")
+			}
+			if result.Segments[0].StartLevel != tt.currentLevel {
+				t.Errorf("First segment should start at %d, got %d", tt.currentLevel, result.Segments[0].StartLevel)
+			}
+			if last := result.Segments[len(result.Segments)-1]; last.EndLevel != tt.targetLevel {
+				t.Errorf("Last segment should end at %d, got %d", tt.targetLevel, last.EndLevel)
+			}
+			if result.TotalLaps <= 0 || result.HoursNeeded <= 0 {
+				t.Errorf("Laps and hours should be positive: %d, %.1f", result.TotalLaps, result.HoursNeeded)
+			}
+			if result.MarksOfGrace <= 0 {
+				t.Errorf("Marks of grace should be positive, got %d", result.MarksOfGrace)
+			}
+		})
+	}
+}
+
+func TestBestCourseSwitchesWithGoal(t *testing.T) {
+	courses, err := Courses()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	forXP, _ := BestCourse(courses, 65, GoalXP, false)
+	forMarks, _ := BestCourse(courses, 65, GoalMarks, false)
+	if forXP.ID != "seers_village" {
+		t.Errorf("Expected Seers' Village for XP at 65, got %s", forXP.ID)
+	}
+	if forMarks.ID != "canifis" {
+		t.Errorf("Expected Canifis for marks at 65, got %s", forMarks.ID)
+	}
+
+	if _, found := BestCourse(courses, 5, GoalXP, false); found {
+		t.Errorf("No rooftop course should be unlocked at level 5")
+	}
+}
+
+func TestArdougneDiaryBoostsMarks(t *testing.T) {
+	without, err := CalculateRooftopData(90, 95, GoalXP, false, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	with, err := CalculateRooftopData(90, 95, GoalXP, true, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := without.MarksPerHour * ArdougneDiaryMarkBonus
+	if with.MarksPerHour < want-1e-9 || with.MarksPerHour > want+1e-9 {
+		t.Errorf("Diary marks per hour = %.2f, want %.2f", with.MarksPerHour, want)
+	}
+}
+
+func TestGracefulBeforeAmylase(t *testing.T) {
+	result, err := CalculateRooftopDataWithPrices(40, 80, GoalMarks, false, true, map[string]int{"Amylase crystal": 100})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result.GracefulMarks != gracefulCost() {
+		t.Errorf("Expected %d marks on Graceful, got %d", gracefulCost(), result.GracefulMarks)
+	}
+	if result.AmylaseMarks != result.MarksOfGrace-gracefulCost() {
+		t.Errorf("Remaining marks should become amylase: %d of %d", result.AmylaseMarks, result.MarksOfGrace)
+	}
+	if result.TotalValue != result.AmylaseCrystals*100 {
+		t.Errorf("Amylase value should use the live price, got %d", result.TotalValue)
+	}
+	for _, piece := range result.Graceful {
+		if !piece.Affordable {
+			t.Errorf("%s should be affordable after %d marks", piece.Name, result.MarksOfGrace)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package runecrafting
+
+import (
+	"fmt"
+
+	"osrs-xp-kits/internal/calculators"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+// RunecraftingInput is the request body accepted by the Runecraft calculator
+type RunecraftingInput struct {
+	CurrentLevel int      `json:"current_level"`
+	TargetLevel  int      `json:"target_level"`
+	Methods      []string `json:"methods,omitempty"` // Empty compares every method available at the current level
+	Pouches      string   `json:"pouches,omitempty"`
+	CompareGOTR  bool     `json:"compare_gotr,omitempty"`
+}
+
+// Calculator exposes Runecraft methods through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the Runecraft calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "runecrafting",
+		Name:        "Runecrafting",
+		Description: "Runes crafted, costs, rune value and XP/GP per hour for ZMI, lava, Arceuus, astral and nature runes, compared with GOTR",
+		Category:    "skilling",
+		Skills:      []string{"runecrafting"},
+	}
+}
+
+// Validate checks the Runecraft input
+func (Calculator) Validate(input RunecraftingInput) error {
+	if input.CurrentLevel < 1 {
+		return fmt.Errorf("runecraft level must be at least 1")
+	}
+	if input.TargetLevel <= input.CurrentLevel {
+		return fmt.Errorf("target level must be greater than current level")
+	}
+	if input.TargetLevel > xp.MaxVirtualLevel {
+		return fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+	for _, id := range input.Methods {
+		method, exists := FindMethod(id)
+		if !exists {
+			return fmt.Errorf("invalid method: %s", id)
+		}
+		if input.CurrentLevel < method.Level {
+			return fmt.Errorf("%s requires %d Runecraft", method.Name, method.Level)
+		}
+	}
+	if _, exists := Pouches[input.Pouches]; input.Pouches != "" && !exists {
+		return fmt.Errorf("invalid pouches: %s", input.Pouches)
+	}
+	if input.CompareGOTR && input.CurrentLevel < MinimumGOTRLevel {
+		return fmt.Errorf("the Guardians of the Rift requires %d Runecraft", MinimumGOTRLevel)
+	}
+	return nil
+}
+
+// Calculate runs the Runecraft calculation
+func (Calculator) Calculate(input RunecraftingInput, opts calculators.Options) (RunecraftingResult, error) {
+	return CalculateRunecraftingDataWithPrices(input.CurrentLevel, input.TargetLevel, input.Methods, input.Pouches, input.CompareGOTR, opts.Prices)
+}
+
+// ProTips returns the Runecraft calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
@@ -0,0 +1,134 @@
+package runecrafting
+
+// Rune is a rune that can be crafted from essence
+type Rune struct {
+	Name  string // Item name, used for live prices
+	Level int
+	XP    float64 // XP per essence at the rune's altar
+	// Multiples are the levels at which each essence gives one more rune, in order
+	Multiples []int
+	Value     int // Default price when live prices are unavailable
+}
+
+// Runes keyed by the name used in requests
+var Runes = map[string]Rune{
+	"air":    {"Air rune", 1, 5, []int{11, 22, 33, 44, 55, 66, 77, 88, 99}, 5},
+	"mind":   {"Mind rune", 2, 5.5, []int{14, 28, 42, 56, 70, 84, 98}, 4},
+	"water":  {"Water rune", 5, 6, []int{19, 38, 57, 76, 95}, 5},
+	"earth":  {"Earth rune", 9, 6.5, []int{26, 52, 78}, 5},
+	"fire":   {"Fire rune", 14, 7, []int{35, 70}, 5},
+	"body":   {"Body rune", 20, 7.5, []int{46, 92}, 5},
+	"cosmic": {"Cosmic rune", 27, 8, []int{59}, 90},
+	"chaos":  {"Chaos rune", 35, 8.5, []int{74}, 60},
+	"astral": {"Astral rune", 40, 8.7, []int{82}, 150},
+	"nature": {"Nature rune", 44, 9, []int{91}, 170},
+	"law":    {"Law rune", 54, 9.5, nil, 150},
+	"death":  {"Death rune", 65, 10, nil, 200},
+	"blood":  {"Blood rune", 77, 10.5, nil, 400},
+	"soul":   {"Soul rune", 90, 11, nil, 250},
+	"lava":   {"Lava rune", 23, 10.5, nil, 10},
+}
+
+// Supply is an item used up while crafting
+type Supply struct {
+	Item       string
+	PerEssence float64
+	PerTrip    float64
+	PerHour    float64
+	Value      int // Default price when live prices are unavailable
+}
+
+// Method is a way of training Runecraft outside the Guardians of the Rift
+type Method struct {
+	ID    string
+	Name  string
+	Level int
+	// Rune is the key of the rune crafted, empty for the Ourania altar's random runes
+	Rune string
+	// XPPerEssence overrides the rune's XP, e.g. for dense essence at the Arceuus altars
+	XPPerEssence float64
+	// Essence is the essence item bought, empty when the essence is mined during the method
+	Essence string
+	// UsesPouches is true when essence pouches can be filled for each trip
+	UsesPouches bool
+	// EssencePerTrip is used when essence pouches cannot be used
+	EssencePerTrip int
+	TripsPerHour   float64
+	Supplies       []Supply
+}
+
+// Methods in the order they are compared
+var Methods = []Method{
+	{
+		ID: "zmi", Name: "Ourania Altar (ZMI)", Level: 1,
+		Essence: "Pure essence", UsesPouches: true, TripsPerHour: 55,
+		Supplies: []Supply{{Item: "Stamina potion(4)", PerHour: 4, Value: 6000}},
+	},
+	{
+		ID: "lava", Name: "Lava runes", Level: 23, Rune: "lava",
+		Essence: "Pure essence", UsesPouches: true, TripsPerHour: 100,
+		Supplies: []Supply{
+			{Item: "Earth rune", PerEssence: 1, Value: 5},
+			{Item: "Binding necklace", PerTrip: 1.0 / BindingNecklaceCharges, Value: 1000},
+			{Item: "Stamina potion(4)", PerHour: 6, Value: 6000},
+		},
+	},
+	{
+		ID: "astral", Name: "Astral runes", Level: 40, Rune: "astral",
+		Essence: "Pure essence", UsesPouches: true, TripsPerHour: 48,
+		Supplies: []Supply{{Item: "Stamina potion(4)", PerHour: 3, Value: 6000}},
+	},
+	{
+		ID: "nature", Name: "Nature runes", Level: 44, Rune: "nature",
+		Essence: "Pure essence", UsesPouches: true, TripsPerHour: 40,
+		Supplies: []Supply{{Item: "Stamina potion(4)", PerHour: 3, Value: 6000}},
+	},
+	{
+		ID: "blood_arceuus", Name: "Blood runes (Arceuus)", Level: 77, Rune: "blood",
+		XPPerEssence: 23.8, EssencePerTrip: 52, TripsPerHour: 30,
+	},
+	{
+		ID: "soul_arceuus", Name: "Soul runes (Arceuus)", Level: 90, Rune: "soul",
+		XPPerEssence: 29.7, EssencePerTrip: 52, TripsPerHour: 30,
+	},
+}
+
+// FindMethod returns the method with the given ID
+func FindMethod(id string) (Method, bool) {
+	for _, method := range Methods {
+		if method.ID == id {
+			return method, true
+		}
+	}
+	return Method{}, false
+}
+
+// Pouch is a set of essence pouches carried on each trip
+type Pouch struct {
+	Slots    int // Inventory slots taken by the pouches
+	Capacity int
+}
+
+// Pouches keyed by the name used in requests
+var Pouches = map[string]Pouch{
+	"none":     {},
+	"giant":    {Slots: 4, Capacity: 30}, // Small, medium, large and giant pouches
+	"colossal": {Slots: 1, Capacity: 40},
+}
+
+// Runecraft constants based on OSRS Wiki
+const (
+	// InventoryEssence is the essence carried in the inventory, leaving room for a stamina potion and rune pouch
+	InventoryEssence = 26
+
+	BindingNecklaceCharges = 16
+
+	// DefaultEssenceValue is the pure essence price when live prices are unavailable
+	DefaultEssenceValue = 3
+
+	// OuraniaXPMultiplier is the bonus XP for runes crafted at the Ourania altar
+	OuraniaXPMultiplier = 1.7
+
+	// MinimumGOTRLevel is the Runecraft level needed to compare a method with the Guardians of the Rift
+	MinimumGOTRLevel = 27
+)
@@ -0,0 +1,345 @@
+package runecrafting
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"osrs-xp-kits/internal/calculators/progression"
+	"osrs-xp-kits/internal/calculators/technique/gotr"
+	"osrs-xp-kits/internal/calculators/tools"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+type RunecraftingResult struct {
+	CurrentLevel int             `json:"current_level"`
+	TargetLevel  int             `json:"target_level"`
+	XPNeeded     int             `json:"xp_needed"`
+	Pouches      string          `json:"pouches"`
+	Methods      []MethodResult  `json:"methods"`
+	GOTR         *GOTRComparison `json:"gotr,omitempty"`
+}
+
+// MethodResult is the cost, value and time of reaching the target with one method
+type MethodResult struct {
+	Method         string       `json:"method"`
+	Name           string       `json:"name"`
+	EssencePerTrip int          `json:"essence_per_trip"`
+	Essence        int          `json:"essence"`
+	Trips          int          `json:"trips"`
+	Runes          []RuneOutput `json:"runes"`
+	Thresholds     []Threshold  `json:"thresholds,omitempty"` // Multiple-rune levels of the rune crafted
+	Supplies       []SupplyCost `json:"supplies"`
+	TotalCost      int          `json:"total_cost"`
+	RuneValue      int          `json:"rune_value"`
+	Profit         int          `json:"profit"`
+	Hours          float64      `json:"hours"`
+	XPPerHour      float64      `json:"xp_per_hour"`
+	GPPerHour      float64      `json:"gp_per_hour"`
+	GPPerXP        float64      `json:"gp_per_xp"`
+}
+
+// RuneOutput is the runes of one kind crafted over the whole grind
+type RuneOutput struct {
+	Rune     string  `json:"rune"`
+	Quantity float64 `json:"quantity"`
+	Price    int     `json:"price"`
+	Value    int     `json:"value"`
+}
+
+// Threshold is the level at which each essence starts giving Multiple runes
+type Threshold struct {
+	Level    int `json:"level"`
+	Multiple int `json:"multiple"`
+}
+
+// SupplyCost is an item used up over the whole grind, essence included
+type SupplyCost struct {
+	Item     string  `json:"item"`
+	Quantity float64 `json:"quantity"`
+	Price    int     `json:"price"`
+	Cost     int     `json:"cost"`
+}
+
+// GOTRComparison is the Guardians of the Rift result for the same level range
+type GOTRComparison struct {
+	Hours            float64 `json:"hours"`
+	XPPerHour        float64 `json:"xp_per_hour"`
+	TotalRewardValue int     `json:"total_reward_value"`
+	GPPerHour        float64 `json:"gp_per_hour"`
+}
+
+func CalculateRunecraftingData(currentLevel, targetLevel int, methodIDs []string, pouches string, compareGOTR bool) (RunecraftingResult, error) {
+	return CalculateRunecraftingDataWithPrices(currentLevel, targetLevel, methodIDs, pouches, compareGOTR, nil)
+}
+
+// CalculateRunecraftingDataWithPrices compares Runecraft methods with optional live rune and supply prices.
+// No method IDs compares every method available at the current level.
+func CalculateRunecraftingDataWithPrices(currentLevel, targetLevel int, methodIDs []string, pouches string, compareGOTR bool, livePrices map[string]int) (RunecraftingResult, error) {
+	if currentLevel < 1 {
+		return RunecraftingResult{}, fmt.Errorf("runecraft level must be at least 1")
+	}
+
+	if targetLevel <= currentLevel {
+		return RunecraftingResult{}, fmt.Errorf("target level must be greater than current level")
+	}
+
+	if targetLevel > xp.MaxVirtualLevel {
+		return RunecraftingResult{}, fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+
+	if pouches == "" {
+		pouches = "none"
+	}
+	pouch, exists := Pouches[pouches]
+	if !exists {
+		return RunecraftingResult{}, fmt.Errorf("invalid pouches: %s", pouches)
+	}
+
+	var methods []Method
+	for _, method := range Methods {
+		if (len(methodIDs) == 0 && currentLevel >= method.Level) || slices.Contains(methodIDs, method.ID) {
+			methods = append(methods, method)
+		}
+	}
+	for _, id := range methodIDs {
+		method, exists := FindMethod(id)
+		if !exists {
+			return RunecraftingResult{}, fmt.Errorf("invalid method: %s", id)
+		}
+		if currentLevel < method.Level {
+			return RunecraftingResult{}, fmt.Errorf("%s requires %d Runecraft", method.Name, method.Level)
+		}
+	}
+
+	result := RunecraftingResult{
+		CurrentLevel: currentLevel,
+		TargetLevel:  targetLevel,
+		XPNeeded:     xp.ForLevel(targetLevel) - xp.ForLevel(currentLevel),
+		Pouches:      pouches,
+	}
+
+	for _, method := range methods {
+		methodResult, err := calculateMethod(method, currentLevel, targetLevel, pouch, livePrices)
+		if err != nil {
+			return RunecraftingResult{}, err
+		}
+		result.Methods = append(result.Methods, methodResult)
+	}
+
+	if compareGOTR {
+		if currentLevel < MinimumGOTRLevel {
+			return RunecraftingResult{}, fmt.Errorf("the Guardians of the Rift requires %d Runecraft", MinimumGOTRLevel)
+		}
+		gotrResult, err := gotr.CalculateGOTRData(currentLevel, targetLevel)
+		if err != nil {
+			return RunecraftingResult{}, err
+		}
+		result.GOTR = &GOTRComparison{
+			Hours:            gotrResult.HoursNeeded,
+			XPPerHour:        gotrResult.AverageXPPerHour,
+			TotalRewardValue: gotrResult.TotalRewardValue,
+			GPPerHour:        gotrResult.GPPerHour,
+		}
+	}
+
+	return result, nil
+}
+
+// calculateMethod walks the grind one essence at a time, so XP and multiple runes follow the level
+func calculateMethod(method Method, currentLevel, targetLevel int, pouch Pouch, livePrices map[string]int) (MethodResult, error) {
+	essencePerTrip := method.EssencePerTrip
+	if method.UsesPouches {
+		essencePerTrip = InventoryEssence - pouch.Slots + pouch.Capacity
+	}
+	essencePerHour := float64(essencePerTrip) * method.TripsPerHour
+
+	levelProgression, err := progression.WalkLevels(currentLevel, targetLevel, func(level int) progression.Rate {
+		return progression.Rate{
+			XPPerAction:    xpPerEssence(method, level),
+			ActionsPerHour: essencePerHour,
+			SuccessChance:  1,
+		}
+	})
+	if err != nil {
+		return MethodResult{}, err
+	}
+
+	runes := make(map[string]float64)
+	for _, segment := range levelProgression.Segments {
+		for key, perEssence := range runesPerEssence(method, min(segment.Level, xp.MaxLevel)) {
+			runes[key] += segment.Actions * perEssence
+		}
+	}
+
+	essence := math.Ceil(levelProgression.TotalActions)
+	trips := math.Ceil(essence / float64(essencePerTrip))
+	hours := levelProgression.TotalHours
+
+	var outputs []RuneOutput
+	runeValue := 0
+	for _, key := range runeOrder(runes) {
+		price := tools.PriceOr(Runes[key].Name, Runes[key].Value, livePrices)
+		value := int(math.Round(runes[key] * float64(price)))
+		outputs = append(outputs, RuneOutput{Rune: Runes[key].Name, Quantity: runes[key], Price: price, Value: value})
+		runeValue += value
+	}
+
+	var supplies []SupplyCost
+	totalCost := 0
+	addSupply := func(item string, quantity float64, defaultValue int) {
+		price := tools.PriceOr(item, defaultValue, livePrices)
+		cost := int(math.Round(quantity * float64(price)))
+		supplies = append(supplies, SupplyCost{Item: item, Quantity: quantity, Price: price, Cost: cost})
+		totalCost += cost
+	}
+	if method.Essence != "" {
+		addSupply(method.Essence, essence, DefaultEssenceValue)
+	}
+	for _, supply := range method.Supplies {
+		addSupply(supply.Item, supply.PerEssence*essence+supply.PerTrip*trips+supply.PerHour*hours, supply.Value)
+	}
+
+	var thresholds []Threshold
+	if crafted, exists := Runes[method.Rune]; exists {
+		for i, level := range crafted.Multiples {
+			thresholds = append(thresholds, Threshold{Level: level, Multiple: i + 2})
+		}
+	}
+
+	profit := runeValue - totalCost
+	return MethodResult{
+		Method:         method.ID,
+		Name:           method.Name,
+		EssencePerTrip: essencePerTrip,
+		Essence:        int(essence),
+		Trips:          int(trips),
+		Runes:          outputs,
+		Thresholds:     thresholds,
+		Supplies:       supplies,
+		TotalCost:      totalCost,
+		RuneValue:      runeValue,
+		Profit:         profit,
+		Hours:          hours,
+		XPPerHour:      levelProgression.AverageXPPerHour,
+		GPPerHour:      float64(profit) / hours,
+		GPPerXP:        float64(-profit) / float64(levelProgression.TotalXP),
+	}, nil
+}
+
+// ouraniaRunes returns the runes the Ourania altar can give at a level, each equally likely
+func ouraniaRunes(level int) []string {
+	var keys []string
+	for key, r := range Runes {
+		if key != "lava" && level >= r.Level {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// xpPerEssence returns the XP for each essence crafted at a level
+func xpPerEssence(method Method, level int) float64 {
+	if method.Rune == "" {
+		keys := ouraniaRunes(level)
+		total := 0.0
+		for _, key := range keys {
+			total += Runes[key].XP
+		}
+		return total / float64(len(keys)) * OuraniaXPMultiplier
+	}
+	if method.XPPerEssence > 0 {
+		return method.XPPerEssence
+	}
+	return Runes[method.Rune].XP
+}
+
+// runesPerEssence returns the runes made from each essence at a level
+func runesPerEssence(method Method, level int) map[string]float64 {
+	if method.Rune == "" {
+		keys := ouraniaRunes(level)
+		runes := make(map[string]float64, len(keys))
+		for _, key := range keys {
+			runes[key] = 1 / float64(len(keys))
+		}
+		return runes
+	}
+	return map[string]float64{method.Rune: float64(RuneMultiple(Runes[method.Rune], level))}
+}
+
+// RuneMultiple returns the runes made from each essence at a level
+func RuneMultiple(r Rune, level int) int {
+	multiple := 1
+	for _, threshold := range r.Multiples {
+		if level >= threshold {
+			multiple++
+		}
+	}
+	return multiple
+}
+
+// runeOrder returns the crafted rune keys ordered by level
+func runeOrder(runes map[string]float64) []string {
+	keys := make([]string, 0, len(runes))
+	for key := range runes {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b string) int { return Runes[a].Level - Runes[b].Level })
+	return keys
+}
+
+// GetCalculationProTips provides detailed information about how Runecraft calculations work
+func GetCalculationProTips() map[string]any {
+	return map[string]any{
+		"calculation_methodology": map[string]any{
+			"xp_rates_source": "Rune XP and multiple-rune levels from the OSRS Wiki",
+			"base_formula":    "XP per hour = XP per essence × essence per trip × trips per hour, walked level by level",
+			"data_points": []map[string]any{
+				{"method": "lava", "pouches": "colossal", "xp_per_hour": 68250},
+				{"method": "zmi", "level": 77, "pouches": "colossal", "xp_per_hour": 47500},
+				{"method": "blood_arceuus", "level": 77, "xp_per_hour": 37100},
+			},
+		},
+		"game_mechanics": map[string]any{
+			"multiple_runes": "Each essence gives one more rune at every multiple-rune level, e.g. 2 nature runes from level 91",
+			"ourania_altar":  "The Ourania altar gives a random rune up to your level with 70% bonus XP",
+			"lava_runes":     "Lava runes use an earth rune per essence and a binding necklace charge per trip at the fire altar",
+			"arceuus":        "Blood and soul runes are made from dense essence mined at the Arceuus altars, so no essence is bought",
+		},
+		"factors_considered": []string{
+			"Runecraft level (affects available methods, multiple runes and Ourania runes)",
+			"Essence pouches carried on each trip",
+			"Essence, earth rune, binding necklace and stamina potion prices",
+			"Rune prices",
+		},
+		"accuracy_notes": map[string]any{
+			"rates_vary": "Trip speed depends on teleports and banking:",
+			"variance_factors": []string{
+				"Pouch repairs and Ourania banking fees are not included",
+				"Abyss trips depend on the obstacle taken and player killers",
+				"Trips per hour are assumed the same with and without pouches",
+			},
+			"calculation_basis": "Steady trips at a typical pace for each method",
+		},
+		"pro_tips": []map[string]string{
+			{
+				"tip":         "Lavas for XP",
+				"description": "Lava runes are the fastest XP outside the Guardians of the Rift but cost gold",
+			},
+			{
+				"tip":         "Arceuus for Profit",
+				"description": "Blood and soul runes need no essence, so nearly all of the rune value is profit",
+			},
+			{
+				"tip":         "Get the Colossal Pouch",
+				"description": "More essence per trip raises both XP and GP per hour for every pouch method",
+			},
+		},
+		"reward_calculation": map[string]any{
+			"rune_value": "Runes crafted × rune price",
+			"total_cost": "Essence + earth runes + binding necklaces + stamina potions",
+			"gp_per_xp":  "(Total cost - rune value) ÷ XP gained",
+		},
+	}
+}
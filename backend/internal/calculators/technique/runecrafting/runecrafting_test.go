@@ -0,0 +1,156 @@
+package runecrafting
+
+import (
+	"math"
+	"testing"
+
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+func TestCalculateRunecraftingData(t *testing.T) {
+	tests := []struct {
+		name         string
+		currentLevel int
+		targetLevel  int
+		methods      []string
+		pouches      string
+		compareGOTR  bool
+		expected     []string
+		expectError  bool
+	}{
+		{
+			name:         "Every method at level 50",
+			currentLevel: 50,
+			targetLevel:  77,
+			pouches:      "colossal",
+			expected:     []string{"zmi", "lava", "astral", "nature"},
+		},
+		{
+			name:         "Arceuus compared with GOTR",
+			currentLevel: 90,
+			targetLevel:  99,
+			methods:      []string{"blood_arceuus", "soul_arceuus"},
+			compareGOTR:  true,
+			expected:     []string{"blood_arceuus", "soul_arceuus"},
+		},
+		{
+			name:         "Method level too high",
+			currentLevel: 50,
+			targetLevel:  77,
+			methods:      []string{"blood_arceuus"},
+			expectError:  true,
+		},
+		{
+			name:         "Invalid pouches",
+			currentLevel: 50,
+			targetLevel:  77,
+			pouches:      "huge",
+			expectError:  true,
+		},
+		{
+			name:         "GOTR below level 27",
+			currentLevel: 10,
+			targetLevel:  30,
+			compareGOTR:  true,
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculateRunecraftingData(tt.currentLevel, tt.targetLevel, tt.methods, tt.pouches, tt.compareGOTR)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(result.Methods) != len(tt.expected) {
+				t.Fatalf("Expected %d methods, got %d", len(tt.expected), len(result.Methods))
+			}
+			for i, method := range result.Methods {
+				if method.Method != tt.expected[i] {
+					t.Errorf("Expected method %s, got %s", tt.expected[i], method.Method)
+				}
+				if method.Hours <= 0 || method.RuneValue <= 0 {
+					t.Errorf("%s: expected positive hours and rune value", method.Method)
+				}
+				if method.Profit != method.RuneValue-method.TotalCost {
+					t.Errorf("%s: expected profit %d, got %d", method.Method, method.RuneValue-method.TotalCost, method.Profit)
+				}
+			}
+			if tt.compareGOTR && result.GOTR == nil {
+				t.Errorf("Expected a GOTR comparison")
+			}
+		})
+	}
+}
+
+func TestRuneMultiple(t *testing.T) {
+	tests := []struct {
+		name     string
+		level    int
+		expected int
+	}{
+		{"nature", 90, 1},
+		{"nature", 91, 2},
+		{"astral", 82, 2},
+		{"air", 99, 10},
+		{"blood", 99, 1},
+	}
+
+	for _, tt := range tests {
+		if got := RuneMultiple(Runes[tt.name], tt.level); got != tt.expected {
+			t.Errorf("%s at %d: expected %d runes per essence, got %d", tt.name, tt.level, tt.expected, got)
+		}
+	}
+}
+
+func TestMultipleRunesFollowLevel(t *testing.T) {
+	result, err := CalculateRunecraftingData(85, 95, []string{"nature"}, "none", false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	method := result.Methods[0]
+	xpBefore91 := float64(xp.ForLevel(91) - xp.ForLevel(85))
+	xpFrom91 := float64(xp.ForLevel(95) - xp.ForLevel(91))
+	expected := xpBefore91/9 + 2*xpFrom91/9
+
+	if math.Abs(method.Runes[0].Quantity-expected) > 1 {
+		t.Errorf("Expected %.0f nature runes, got %.0f", expected, method.Runes[0].Quantity)
+	}
+	if len(method.Thresholds) != 1 || method.Thresholds[0] != (Threshold{Level: 91, Multiple: 2}) {
+		t.Errorf("Expected a single threshold at 91, got %v", method.Thresholds)
+	}
+}
+
+func TestLavaSupplies(t *testing.T) {
+	livePrices := map[string]int{"Pure essence": 4, "Earth rune": 6, "Lava rune": 12}
+	result, err := CalculateRunecraftingDataWithPrices(50, 60, []string{"lava"}, "colossal", false, livePrices)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	method := result.Methods[0]
+	if method.EssencePerTrip != InventoryEssence-1+40 {
+		t.Errorf("Expected %d essence per trip, got %d", InventoryEssence-1+40, method.EssencePerTrip)
+	}
+
+	costs := make(map[string]SupplyCost)
+	for _, supply := range method.Supplies {
+		costs[supply.Item] = supply
+	}
+	if costs["Earth rune"].Quantity != float64(method.Essence) || costs["Earth rune"].Price != 6 {
+		t.Errorf("Expected one earth rune per essence at 6 gp, got %f at %d", costs["Earth rune"].Quantity, costs["Earth rune"].Price)
+	}
+	if expected := float64(method.Trips) / BindingNecklaceCharges; math.Abs(costs["Binding necklace"].Quantity-expected) > 1e-9 {
+		t.Errorf("Expected %f binding necklaces, got %f", expected, costs["Binding necklace"].Quantity)
+	}
+}
@@ -0,0 +1,59 @@
+package sepulchre
+
+import (
+	"fmt"
+
+	"osrs-xp-kits/internal/calculators"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+// SepulchreInput is the request body accepted by the Hallowed Sepulchre calculator
+type SepulchreInput struct {
+	AgilityLevel int     `json:"agility_level"`
+	HighestFloor int     `json:"highest_floor,omitempty"` // 0 runs every unlocked floor
+	Hours        float64 `json:"hours,omitempty"`         // 0 defaults to one hour
+}
+
+// Calculator exposes the Hallowed Sepulchre through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the Hallowed Sepulchre calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "sepulchre",
+		Name:        "Hallowed Sepulchre",
+		Description: "Agility XP, Hallowed marks, coffin loot and Ring of endurance odds from Hallowed Sepulchre runs",
+		Category:    "skilling",
+		Skills:      []string{"agility"},
+	}
+}
+
+// Validate checks the Hallowed Sepulchre input
+func (Calculator) Validate(input SepulchreInput) error {
+	if input.AgilityLevel < MinimumAgilityLevel {
+		return fmt.Errorf("agility level must be at least %d", MinimumAgilityLevel)
+	}
+	if input.AgilityLevel > xp.MaxLevel {
+		return fmt.Errorf("agility level must be at most %d", xp.MaxLevel)
+	}
+	if input.HighestFloor < 0 || input.HighestFloor > len(Floors) {
+		return fmt.Errorf("highest floor must be between 1 and %d", len(Floors))
+	}
+	if input.HighestFloor > UnlockedFloors(input.AgilityLevel) {
+		return fmt.Errorf("floor %d requires %d Agility", input.HighestFloor, Floors[input.HighestFloor-1].Level)
+	}
+	if input.Hours < 0 {
+		return fmt.Errorf("hours must not be negative")
+	}
+	return nil
+}
+
+// Calculate runs the Hallowed Sepulchre calculation
+func (Calculator) Calculate(input SepulchreInput, opts calculators.Options) (SepulchreResult, error) {
+	return CalculateSepulchreDataWithPrices(input.AgilityLevel, input.HighestFloor, input.Hours, opts.Prices)
+}
+
+// ProTips returns the Hallowed Sepulchre calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
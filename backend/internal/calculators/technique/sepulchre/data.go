@@ -0,0 +1,66 @@
+package sepulchre
+
+import "osrs-xp-kits/internal/calculators/tools"
+
+// Floor is one floor of the Hallowed Sepulchre
+type Floor struct {
+	Number  int
+	Level   int     // Agility level needed to enter the floor
+	XP      float64 // Agility XP for the floor's obstacles and its completion bonus
+	Minutes float64 // Average time to complete the floor
+	// MinMarks and MaxMarks give the range of Hallowed marks from the floor's coffins
+	MinMarks int
+	MaxMarks int
+	// Uniques are rolled independently every time the floor's grand coffin is looted
+	Uniques tools.DropTable
+}
+
+// Floors in the order they are run. A run starts on floor 1 and stops after the highest floor reached.
+var Floors = []Floor{
+	{Number: 1, Level: 52, XP: 575, Minutes: 0.9, MinMarks: 1, MaxMarks: 1},
+	{Number: 2, Level: 62, XP: 925, Minutes: 1.1, MinMarks: 1, MaxMarks: 2},
+	{Number: 3, Level: 72, XP: 1500, Minutes: 1.4, MinMarks: 2, MaxMarks: 3},
+	{Number: 4, Level: 82, XP: 2700, Minutes: 1.9, MinMarks: 3, MaxMarks: 4},
+	{
+		Number: 5, Level: 92, XP: 6000, Minutes: 2.8, MinMarks: 5, MaxMarks: 8,
+		Uniques: tools.DropTable{
+			{Name: "Ring of endurance", Probability: 1.0 / 1000, Price: 15000000},
+		},
+	},
+}
+
+// Sepulchre constants based on OSRS Wiki
+const (
+	MinimumAgilityLevel = 52
+
+	// RunOverheadMinutes is the time spent in the lobby and banking between runs
+	RunOverheadMinutes = 0.75
+)
+
+// CoffinLoot is the grand coffin table for floor 1, rolled once per floor completed.
+// Quantities are multiplied by the floor number, so deeper floors give more of the same loot.
+var CoffinLoot = tools.DropTable{
+	{Name: "Coins", Probability: 0.30, Price: 1, MinQuantity: 500, MaxQuantity: 1500},
+	{Name: "Death rune", Probability: 0.12, Price: 200, MinQuantity: 20, MaxQuantity: 40},
+	{Name: "Blood rune", Probability: 0.12, Price: 350, MinQuantity: 15, MaxQuantity: 30},
+	{Name: "Law rune", Probability: 0.10, Price: 150, MinQuantity: 15, MaxQuantity: 30},
+	{Name: "Nature rune", Probability: 0.10, Price: 180, MinQuantity: 15, MaxQuantity: 30},
+	{Name: "Pure essence", Probability: 0.10, Price: 3, MinQuantity: 50, MaxQuantity: 100},
+	{Name: "Adamant arrow", Probability: 0.08, Price: 60, MinQuantity: 20, MaxQuantity: 50},
+	{Name: "Rune arrow", Probability: 0.04, Price: 150, MinQuantity: 10, MaxQuantity: 25},
+	{Name: "Shark", Probability: 0.04, Price: 900, MinQuantity: 2, MaxQuantity: 4},
+}
+
+// MarkReward is an item bought from the Hallowed Sepulchre reward shop
+type MarkReward struct {
+	Name string `json:"name"`
+	Cost int    `json:"cost"` // Hallowed marks
+}
+
+// MarkRewards in the order they are usually bought, cheapest first
+var MarkRewards = []MarkReward{
+	{"Hallowed grapple", 100},
+	{"Hallowed focus", 100},
+	{"Hallowed symbol", 100},
+	{"Hallowed ring", 250},
+}
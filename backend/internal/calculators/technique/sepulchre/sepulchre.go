@@ -0,0 +1,264 @@
+package sepulchre
+
+import (
+	"fmt"
+	"math"
+
+	"osrs-xp-kits/internal/calculators/probability"
+	"osrs-xp-kits/internal/calculators/tools"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+type SepulchreResult struct {
+	AgilityLevel  int            `json:"agility_level"`
+	HighestFloor  int            `json:"highest_floor"`
+	Hours         float64        `json:"hours"`
+	Floors        []FloorSummary `json:"floors"`
+	XPPerRun      float64        `json:"xp_per_run"`
+	MinutesPerRun float64        `json:"minutes_per_run"`
+	RunsPerHour   float64        `json:"runs_per_hour"`
+	XPPerHour     float64        `json:"xp_per_hour"`
+	MarksPerRun   float64        `json:"marks_per_run"`
+	MarksPerHour  float64        `json:"marks_per_hour"`
+	TotalRuns     int            `json:"total_runs"`
+	TotalXP       int            `json:"total_xp"`
+	TotalMarks    int            `json:"total_marks"`
+	TotalValue    int            `json:"total_value"`
+	GPPerHour     float64        `json:"gp_per_hour"`
+
+	MarkRewards []MarkRewardProgress   `json:"mark_rewards"`
+	CoffinLoot  tools.ExpectedLoot     `json:"coffin_loot"`
+	UniqueOdds  []probability.DropOdds `json:"unique_odds"`
+}
+
+// FloorSummary is what one floor adds to every run
+type FloorSummary struct {
+	Number      int                `json:"number"`
+	Level       int                `json:"level"`
+	XP          float64            `json:"xp"`
+	Minutes     float64            `json:"minutes"`
+	MarksPerRun float64            `json:"marks_per_run"`
+	Loot        tools.ExpectedLoot `json:"loot"` // Coffin loot and uniques from one completion
+}
+
+// MarkRewardProgress tracks when a reward can be bought, buying rewards in order
+type MarkRewardProgress struct {
+	MarkReward
+	CumulativeCost int     `json:"cumulative_cost"`
+	HoursToAfford  float64 `json:"hours_to_afford"`
+	Affordable     bool    `json:"affordable"`
+}
+
+// CalculateSepulchreData calculates Hallowed Sepulchre data with default prices.
+// highestFloor of 0 runs every floor unlocked at the Agility level.
+func CalculateSepulchreData(agilityLevel, highestFloor int, hours float64) (SepulchreResult, error) {
+	return CalculateSepulchreDataWithPrices(agilityLevel, highestFloor, hours, nil)
+}
+
+// CalculateSepulchreDataWithPrices calculates Hallowed Sepulchre data with optional live loot prices
+func CalculateSepulchreDataWithPrices(agilityLevel, highestFloor int, hours float64, livePrices map[string]int) (SepulchreResult, error) {
+	if agilityLevel < MinimumAgilityLevel {
+		return SepulchreResult{}, fmt.Errorf("agility level must be at least %d", MinimumAgilityLevel)
+	}
+
+	if agilityLevel > xp.MaxLevel {
+		return SepulchreResult{}, fmt.Errorf("agility level must be at most %d", xp.MaxLevel)
+	}
+
+	unlocked := UnlockedFloors(agilityLevel)
+	if highestFloor == 0 {
+		highestFloor = unlocked
+	}
+	if highestFloor < 1 || highestFloor > len(Floors) {
+		return SepulchreResult{}, fmt.Errorf("highest floor must be between 1 and %d", len(Floors))
+	}
+	if highestFloor > unlocked {
+		return SepulchreResult{}, fmt.Errorf("floor %d requires %d Agility", highestFloor, Floors[highestFloor-1].Level)
+	}
+
+	if hours < 0 {
+		return SepulchreResult{}, fmt.Errorf("hours must not be negative")
+	}
+	if hours == 0 {
+		hours = 1
+	}
+
+	floors := Floors[:highestFloor]
+	summaries := make([]FloorSummary, 0, len(floors))
+	xpPerRun, minutesPerRun, marksPerRun := 0.0, RunOverheadMinutes, 0.0
+	for _, floor := range floors {
+		marks := float64(floor.MinMarks+floor.MaxMarks) / 2
+		summaries = append(summaries, FloorSummary{
+			Number:      floor.Number,
+			Level:       floor.Level,
+			XP:          floor.XP,
+			Minutes:     floor.Minutes,
+			MarksPerRun: marks,
+			Loot:        floorLoot(floor, 1, livePrices),
+		})
+		xpPerRun += floor.XP
+		minutesPerRun += floor.Minutes
+		marksPerRun += marks
+	}
+
+	runsPerHour := 60 / minutesPerRun
+	totalRuns := int(math.Round(runsPerHour * hours))
+
+	// Every completed floor rolls its own coffin, so the loot is the sum of the floors
+	parts := make([]tools.ExpectedLoot, 0, len(floors))
+	for _, floor := range floors {
+		parts = append(parts, floorLoot(floor, totalRuns, livePrices))
+	}
+	coffinLoot := tools.MergeExpected(parts...)
+	totalValue := int(math.Round(coffinLoot.TotalValue))
+
+	totalMarks := int(math.Round(marksPerRun * float64(totalRuns)))
+	marksPerHour := marksPerRun * runsPerHour
+
+	uniqueOdds, err := CalculateUniqueOdds(highestFloor, totalRuns)
+	if err != nil {
+		return SepulchreResult{}, err
+	}
+
+	return SepulchreResult{
+		AgilityLevel:  agilityLevel,
+		HighestFloor:  highestFloor,
+		Hours:         hours,
+		Floors:        summaries,
+		XPPerRun:      xpPerRun,
+		MinutesPerRun: minutesPerRun,
+		RunsPerHour:   runsPerHour,
+		XPPerHour:     xpPerRun * runsPerHour,
+		MarksPerRun:   marksPerRun,
+		MarksPerHour:  marksPerHour,
+		TotalRuns:     totalRuns,
+		TotalXP:       int(math.Round(xpPerRun * float64(totalRuns))),
+		TotalMarks:    totalMarks,
+		TotalValue:    totalValue,
+		GPPerHour:     float64(totalValue) / hours,
+		MarkRewards:   markRewardProgress(totalMarks, marksPerHour),
+		CoffinLoot:    coffinLoot,
+		UniqueOdds:    uniqueOdds,
+	}, nil
+}
+
+// UnlockedFloors returns the highest floor that can be entered at an Agility level
+func UnlockedFloors(agilityLevel int) int {
+	unlocked := 0
+	for _, floor := range Floors {
+		if agilityLevel >= floor.Level {
+			unlocked = floor.Number
+		}
+	}
+	return unlocked
+}
+
+// CoffinTable returns the grand coffin table of a floor, with quantities scaled by the floor number
+func CoffinTable(floor int, livePrices map[string]int) tools.DropTable {
+	table := CoffinLoot.WithPrices(livePrices)
+	for i := range table {
+		table[i].MinQuantity *= floor
+		table[i].MaxQuantity *= floor
+	}
+	return table
+}
+
+// floorLoot returns the expected coffin loot and uniques from completing a floor the given number of times
+func floorLoot(floor Floor, runs int, livePrices map[string]int) tools.ExpectedLoot {
+	coffin := CoffinTable(floor.Number, livePrices).Expected(runs)
+	if len(floor.Uniques) == 0 {
+		return coffin
+	}
+	return tools.MergeExpected(coffin, floor.Uniques.WithPrices(livePrices).ExpectedIndependent(runs))
+}
+
+// CalculateUniqueOdds returns the odds of every coffin unique available up to the highest floor over the given runs
+func CalculateUniqueOdds(highestFloor, runs int) ([]probability.DropOdds, error) {
+	var uniques []probability.Unique
+	for _, floor := range Floors[:highestFloor] {
+		for _, item := range floor.Uniques {
+			uniques = append(uniques, probability.Unique{Name: item.Name, Rate: item.Probability})
+		}
+	}
+	return probability.UniqueOdds(uniques, runs)
+}
+
+// UniqueDropOdds returns the odds of the coffin uniques
+func (r SepulchreResult) UniqueDropOdds() []probability.DropOdds {
+	return r.UniqueOdds
+}
+
+// markRewardProgress returns when each mark reward is affordable, buying them in order
+func markRewardProgress(totalMarks int, marksPerHour float64) []MarkRewardProgress {
+	progress := make([]MarkRewardProgress, 0, len(MarkRewards))
+	cumulative := 0
+	for _, reward := range MarkRewards {
+		cumulative += reward.Cost
+		progress = append(progress, MarkRewardProgress{
+			MarkReward:     reward,
+			CumulativeCost: cumulative,
+			HoursToAfford:  float64(cumulative) / marksPerHour,
+			Affordable:     totalMarks >= cumulative,
+		})
+	}
+	return progress
+}
+
+// GetCalculationProTips provides detailed information about how Hallowed Sepulchre calculations work
+func GetCalculationProTips() map[string]any {
+	return map[string]any{
+		"calculation_methodology": map[string]any{
+			"xp_rates_source": "Based on OSRS Wiki floor XP and community run times",
+			"base_formula":    "Sum of floor XP for floors 1 to the highest floor × runs per hour",
+			"data_points": []map[string]any{
+				{"level": 52, "floors": 1, "xp_per_hour": 21000, "note": "Floor 1 only"},
+				{"level": 72, "floors": 3, "xp_per_hour": 43000, "note": "Floors 1-3"},
+				{"level": 82, "floors": 4, "xp_per_hour": 57000, "note": "Floors 1-4"},
+				{"level": 92, "floors": 5, "xp_per_hour": 79000, "note": "Full runs"},
+			},
+		},
+		"game_mechanics": map[string]any{
+			"floor_requirements": "Floors unlock at 52, 62, 72, 82 and 92 Agility",
+			"run_structure":      "Every run starts on floor 1 and ends after the highest floor attempted",
+			"xp_sources": []string{
+				"Obstacles on every floor",
+				"Floor completion bonus, largest on floor 5",
+			},
+		},
+		"factors_considered": []string{
+			"Agility level (affects floors unlocked)",
+			"Highest floor attempted each run",
+			"Grand coffin loot rolled on every floor completed",
+			"Hallowed marks toward the reward shop",
+		},
+		"accuracy_notes": map[string]any{
+			"rates_vary": "Individual XP rates can vary ±20% based on:",
+			"variance_factors": []string{
+				"Failed obstacles sending you back to the start of the floor",
+				"Learning the statue and arrow patterns",
+				"Time spent looting coffins",
+			},
+			"calculation_basis": "Rates assume completed floors without failures",
+		},
+		"pro_tips": []map[string]string{
+			{
+				"tip":         "Skip Early Floors Carefully",
+				"description": "Floor 5 gives over half the XP of a full run, so reliable floor 5 completions matter most",
+			},
+			{
+				"tip":         "Loot Every Grand Coffin",
+				"description": "Each floor's grand coffin rolls its own loot and marks, scaling with the floor",
+			},
+			{
+				"tip":         "Stamina Potions",
+				"description": "Running between obstacles drains energy quickly, so keep run energy topped up on the deeper floors",
+			},
+		},
+		"reward_calculation": map[string]any{
+			"coffin_loot":  "One grand coffin roll per floor completed, quantities scaled by the floor number",
+			"uniques":      "Ring of endurance rolled independently from the floor 5 grand coffin",
+			"mark_rewards": "Hallowed grapple, focus, symbol and ring bought in order with Hallowed marks",
+			"gp_per_hour":  "Calculated from tradeable coffin loot and uniques averaged over time",
+		},
+	}
+}
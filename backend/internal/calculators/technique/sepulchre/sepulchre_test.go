@@ -0,0 +1,130 @@
+package sepulchre
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalculateSepulchreData(t *testing.T) {
+	tests := []struct {
+		name         string
+		agilityLevel int
+		highestFloor int
+		wantFloor    int
+		wantUniques  int
+		expectError  bool
+	}{
+		{
+			name:         "Level 52 defaults to floor 1",
+			agilityLevel: 52,
+			wantFloor:    1,
+		},
+		{
+			name:         "Level 85 stopping at floor 3",
+			agilityLevel: 85,
+			highestFloor: 3,
+			wantFloor:    3,
+		},
+		{
+			name:         "Level 99 full runs",
+			agilityLevel: 99,
+			wantFloor:    5,
+			wantUniques:  1,
+		},
+		{
+			name:         "Invalid level (too low)",
+			agilityLevel: 51,
+			expectError:  true,
+		},
+		{
+			name:         "Floor not unlocked",
+			agilityLevel: 70,
+			highestFloor: 3,
+			expectError:  true,
+		},
+		{
+			name:         "Floor out of range",
+			agilityLevel: 99,
+			highestFloor: 6,
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculateSepulchreData(tt.agilityLevel, tt.highestFloor, 10)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result.HighestFloor != tt.wantFloor || len(result.Floors) != tt.wantFloor {
+				t.Errorf("Expected %d floors, got highest %d with %d floors", tt.wantFloor, result.HighestFloor, len(result.Floors))
+			}
+			if result.XPPerHour <= 0 || result.MarksPerHour <= 0 {
+				t.Errorf("XP and marks per hour should be positive: %.0f, %.1f", result.XPPerHour, result.MarksPerHour)
+			}
+			if result.TotalValue <= 0 || result.GPPerHour <= 0 {
+				t.Errorf("Coffin loot should have value, got %d", result.TotalValue)
+			}
+			if len(result.UniqueOdds) != tt.wantUniques {
+				t.Errorf("Expected %d unique odds, got %d", tt.wantUniques, len(result.UniqueOdds))
+			}
+			if len(result.MarkRewards) != len(MarkRewards) {
+				t.Errorf("Expected progress for %d mark rewards, got %d", len(MarkRewards), len(result.MarkRewards))
+			}
+		})
+	}
+}
+
+func TestDeeperFloorsGiveMoreXPPerHour(t *testing.T) {
+	previous := 0.0
+	for floor := 1; floor <= len(Floors); floor++ {
+		result, err := CalculateSepulchreData(99, floor, 1)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.XPPerHour <= previous {
+			t.Errorf("Floor %d should give more XP per hour than %.0f, got %.0f", floor, previous, result.XPPerHour)
+		}
+		previous = result.XPPerHour
+	}
+}
+
+func TestCoffinLootIsSumOfFloors(t *testing.T) {
+	result, err := CalculateSepulchreData(99, 5, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	perRun := 0.0
+	for _, floor := range result.Floors {
+		perRun += floor.Loot.TotalValue
+	}
+	want := perRun * float64(result.TotalRuns)
+	if math.Abs(result.CoffinLoot.TotalValue-want) > 1e-6*want {
+		t.Errorf("Coffin loot value %.0f should be per-run floor loot × runs %.0f", result.CoffinLoot.TotalValue, want)
+	}
+}
+
+func TestLivePricesOverrideDefaults(t *testing.T) {
+	base, err := CalculateSepulchreData(99, 5, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	priced, err := CalculateSepulchreDataWithPrices(99, 5, 1, map[string]int{"Ring of endurance": 30000000})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if priced.GPPerHour <= base.GPPerHour {
+		t.Errorf("A higher Ring of endurance price should raise GP per hour: %.0f vs %.0f", priced.GPPerHour, base.GPPerHour)
+	}
+}
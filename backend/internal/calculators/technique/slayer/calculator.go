@@ -0,0 +1,52 @@
+package slayer
+
+import (
+	"osrs-xp-kits/internal/calculators"
+)
+
+// SlayerInput is the request body accepted by the Slayer calculator.
+// Exactly one of Tasks and TargetLevel must be set.
+type SlayerInput struct {
+	Master         string   `json:"master"`
+	CombatLevel    int      `json:"combat_level"`
+	CurrentLevel   int      `json:"current_level"`
+	Tasks          int      `json:"tasks,omitempty"`
+	TargetLevel    int      `json:"target_level,omitempty"`
+	CombatStyle    string   `json:"combat_style"`
+	Unlocks        []string `json:"unlocks,omitempty"`
+	Extensions     []string `json:"extensions,omitempty"`
+	Blocked        []string `json:"blocked,omitempty"`
+	Preferred      []string `json:"preferred,omitempty"` // Other tasks are skipped while points allow
+	StartingPoints int      `json:"starting_points,omitempty"`
+	StartingStreak int      `json:"starting_streak,omitempty"`
+}
+
+// Calculator exposes Slayer through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the Slayer calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "slayer",
+		Name:        "Slayer",
+		Description: "Simulated Slayer tasks by master with task frequency, kills, Slayer and combat XP, points and time",
+		Category:    "combat",
+		Skills:      []string{"slayer", "attack", "strength", "defence", "ranged", "magic", "hitpoints"},
+	}
+}
+
+// Validate checks the Slayer input
+func (Calculator) Validate(input SlayerInput) error {
+	_, err := validateInput(input)
+	return err
+}
+
+// Calculate runs the Slayer simulation
+func (Calculator) Calculate(input SlayerInput, opts calculators.Options) (SlayerResult, error) {
+	return CalculateSlayerDataWithSeed(input, opts.Seed)
+}
+
+// ProTips returns the Slayer calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
@@ -0,0 +1,213 @@
+package slayer
+
+// Monster is a Slayer task monster
+type Monster struct {
+	SlayerLevel  int
+	HP           float64 // Slayer XP per kill
+	KillsPerHour float64
+	// Unlock is the reward that must be bought before the monster can be assigned
+	Unlock string
+	// Extension is the reward that raises the task quantity
+	Extension string
+}
+
+// Monsters keyed by task name
+var Monsters = map[string]Monster{
+	// Low-level tasks
+	"Birds":          {1, 5, 600, "", ""},
+	"Bats":           {1, 8, 500, "", ""},
+	"Cows":           {1, 8, 500, "", ""},
+	"Goblins":        {1, 5, 600, "", ""},
+	"Crawling hands": {5, 16, 400, "", ""},
+	"Cave bugs":      {7, 5, 500, "", ""},
+	"Banshees":       {15, 22, 350, "", ""},
+	"Hill giants":    {1, 35, 300, "", ""},
+	"Moss giants":    {1, 40, 280, "", ""},
+	"Cockatrice":     {25, 37, 300, "", ""},
+	"Pyrefiends":     {30, 45, 300, "", ""},
+
+	// Mid and high-level tasks
+	"Aberrant spectres": {60, 90, 220, "", "Smell ya later"},
+	"Abyssal demons":    {85, 150, 180, "", "Augment my abbies"},
+	"Black demons":      {1, 157, 150, "", "It's dark in here"},
+	"Bloodveld":         {50, 120, 220, "", "Bleed me dry"},
+	"Cave horrors":      {58, 55, 200, "", "Horrorific"},
+	"Dagannoth":         {1, 70, 280, "", ""},
+	"Dark beasts":       {90, 220, 130, "", "Need more darkness"},
+	"Dust devils":       {65, 105, 350, "", "To dust you shall return"},
+	"Fire giants":       {1, 111, 200, "", ""},
+	"Gargoyles":         {75, 105, 170, "", "Get smashed"},
+	"Greater demons":    {1, 87, 200, "", "Greater challenge"},
+	"Hellhounds":        {1, 116, 180, "", ""},
+	"Kalphite":          {1, 40, 350, "", ""},
+	"Kurask":            {70, 97, 170, "", ""},
+	"Nechryael":         {80, 105, 280, "", "Nechs please"},
+	"Skeletal wyverns":  {72, 210, 90, "", "Wyver-nother one"},
+	"Smoke devils":      {93, 185, 300, "", ""},
+	"Trolls":            {1, 90, 200, "", ""},
+
+	// Tasks that must be unlocked with points
+	"Aviansies":   {1, 69, 120, "Watch the birdie", ""},
+	"Lizardmen":   {1, 60, 200, "Reptile got ripped", ""},
+	"Red dragons": {1, 140, 90, "Seeing red", ""},
+}
+
+// Assignment is a task a master can give, with its weight and quantity ranges
+type Assignment struct {
+	Task   string
+	Weight int
+	Min    int
+	Max    int
+	// ExtendedMin and ExtendedMax replace the range once the monster's extension is bought.
+	// Both zero means the task cannot be extended by this master.
+	ExtendedMin int
+	ExtendedMax int
+}
+
+// Master is a Slayer master with their task list and points per task
+type Master struct {
+	Name          string
+	CombatLevel   int
+	SlayerLevel   int
+	PointsPerTask int
+	Assignments   []Assignment
+}
+
+// Masters keyed by the name used in requests
+var Masters = map[string]Master{
+	"turael": {
+		Name: "Turael", PointsPerTask: 0,
+		Assignments: []Assignment{
+			{"Birds", 6, 15, 50, 0, 0},
+			{"Bats", 7, 15, 50, 0, 0},
+			{"Cows", 8, 15, 50, 0, 0},
+			{"Goblins", 7, 15, 50, 0, 0},
+			{"Crawling hands", 8, 15, 50, 0, 0},
+			{"Cave bugs", 8, 10, 20, 0, 0},
+			{"Banshees", 8, 15, 50, 0, 0},
+		},
+	},
+	"vannaka": {
+		Name: "Vannaka", CombatLevel: 40, PointsPerTask: 4,
+		Assignments: []Assignment{
+			{"Aberrant spectres", 8, 60, 120, 0, 0},
+			{"Bloodveld", 8, 60, 120, 0, 0},
+			{"Cockatrice", 8, 60, 120, 0, 0},
+			{"Dagannoth", 7, 60, 120, 0, 0},
+			{"Hill giants", 7, 60, 120, 0, 0},
+			{"Kalphite", 7, 60, 120, 0, 0},
+			{"Moss giants", 7, 60, 120, 0, 0},
+			{"Pyrefiends", 8, 60, 120, 0, 0},
+			{"Trolls", 7, 60, 120, 0, 0},
+			{"Lizardmen", 8, 60, 120, 0, 0},
+		},
+	},
+	"chaeldar": {
+		Name: "Chaeldar", CombatLevel: 70, PointsPerTask: 10,
+		Assignments: []Assignment{
+			{"Aberrant spectres", 8, 110, 170, 200, 250},
+			{"Black demons", 10, 110, 170, 200, 250},
+			{"Bloodveld", 8, 110, 170, 200, 250},
+			{"Cave horrors", 10, 110, 170, 200, 250},
+			{"Dagannoth", 11, 110, 170, 0, 0},
+			{"Dust devils", 9, 110, 170, 200, 250},
+			{"Fire giants", 12, 110, 170, 0, 0},
+			{"Gargoyles", 11, 110, 170, 200, 250},
+			{"Kurask", 12, 110, 170, 0, 0},
+			{"Trolls", 11, 110, 170, 0, 0},
+			{"Lizardmen", 8, 50, 80, 0, 0},
+		},
+	},
+	"nieve": {
+		Name: "Nieve", CombatLevel: 85, PointsPerTask: 12,
+		Assignments: []Assignment{
+			{"Abyssal demons", 9, 120, 185, 200, 250},
+			{"Black demons", 9, 120, 185, 200, 250},
+			{"Bloodveld", 9, 120, 185, 200, 250},
+			{"Dark beasts", 5, 10, 20, 110, 135},
+			{"Dust devils", 6, 120, 185, 200, 250},
+			{"Fire giants", 9, 120, 185, 0, 0},
+			{"Gargoyles", 6, 120, 185, 200, 250},
+			{"Greater demons", 7, 120, 185, 150, 200},
+			{"Hellhounds", 8, 120, 185, 0, 0},
+			{"Kalphite", 9, 120, 185, 0, 0},
+			{"Nechryael", 7, 110, 170, 200, 250},
+			{"Skeletal wyverns", 5, 5, 15, 50, 70},
+			{"Smoke devils", 7, 120, 185, 0, 0},
+			{"Trolls", 6, 120, 185, 0, 0},
+			{"Aviansies", 6, 120, 185, 0, 0},
+			{"Red dragons", 5, 30, 80, 0, 0},
+		},
+	},
+	"duradel": {
+		Name: "Duradel", CombatLevel: 100, SlayerLevel: 50, PointsPerTask: 15,
+		Assignments: []Assignment{
+			{"Abyssal demons", 12, 130, 200, 200, 250},
+			{"Black demons", 8, 130, 200, 200, 250},
+			{"Bloodveld", 8, 130, 200, 200, 250},
+			{"Dagannoth", 9, 130, 200, 0, 0},
+			{"Dark beasts", 11, 10, 20, 110, 135},
+			{"Dust devils", 5, 130, 200, 200, 250},
+			{"Fire giants", 7, 130, 200, 0, 0},
+			{"Gargoyles", 8, 130, 200, 200, 250},
+			{"Greater demons", 9, 130, 200, 150, 200},
+			{"Hellhounds", 10, 130, 200, 0, 0},
+			{"Kalphite", 9, 130, 200, 0, 0},
+			{"Kurask", 4, 130, 200, 0, 0},
+			{"Nechryael", 9, 130, 200, 200, 250},
+			{"Skeletal wyverns", 7, 20, 40, 50, 70},
+			{"Smoke devils", 9, 130, 200, 0, 0},
+			{"Trolls", 6, 130, 200, 0, 0},
+			{"Aviansies", 8, 120, 200, 0, 0},
+			{"Red dragons", 8, 30, 65, 0, 0},
+		},
+	},
+}
+
+// CombatStyle decides which combat skills gain XP from the damage dealt
+type CombatStyle string
+
+const (
+	StyleAttack     CombatStyle = "attack"
+	StyleStrength   CombatStyle = "strength"
+	StyleDefence    CombatStyle = "defence"
+	StyleControlled CombatStyle = "controlled"
+	StyleRanged     CombatStyle = "ranged"
+	StyleMagic      CombatStyle = "magic"
+)
+
+// CombatXPPerDamage is the XP per point of damage dealt for each style, Hitpoints included
+var CombatXPPerDamage = map[CombatStyle]map[string]float64{
+	StyleAttack:     {"attack": 4, "hitpoints": 4.0 / 3},
+	StyleStrength:   {"strength": 4, "hitpoints": 4.0 / 3},
+	StyleDefence:    {"defence": 4, "hitpoints": 4.0 / 3},
+	StyleControlled: {"attack": 4.0 / 3, "strength": 4.0 / 3, "defence": 4.0 / 3, "hitpoints": 4.0 / 3},
+	StyleRanged:     {"ranged": 4, "hitpoints": 4.0 / 3},
+	StyleMagic:      {"magic": 2, "hitpoints": 4.0 / 3},
+}
+
+// Slayer reward and task constants based on OSRS Wiki
+const (
+	SkipCost  = 30
+	MaxBlocks = 6
+	MaxPoints = 64000
+
+	// TaskOverheadMinutes covers getting the task, banking and travelling
+	TaskOverheadMinutes = 6.0
+
+	// MaxSimulatedTasks stops a run toward a target level that could never finish.
+	// Skipped assignments count toward it too.
+	MaxSimulatedTasks = 20000
+)
+
+// Streak milestones multiply the master's points, checked from the largest
+var streakMilestones = []struct {
+	Every      int
+	Multiplier int
+}{
+	{1000, 50},
+	{250, 35},
+	{100, 25},
+	{50, 15},
+	{10, 5},
+}
@@ -0,0 +1,409 @@
+package slayer
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"time"
+
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+type SlayerResult struct {
+	Master         string             `json:"master"`
+	CombatStyle    string             `json:"combat_style"`
+	CurrentLevel   int                `json:"current_level"`
+	TargetLevel    int                `json:"target_level,omitempty"`
+	FinalLevel     int                `json:"final_level"`
+	TasksCompleted int                `json:"tasks_completed"`
+	TasksSkipped   int                `json:"tasks_skipped"`
+	Tasks          []TaskStats        `json:"tasks"`
+	TotalKills     int                `json:"total_kills"`
+	SlayerXP       float64            `json:"slayer_xp"`
+	CombatXP       map[string]float64 `json:"combat_xp"`
+	PointsEarned   int                `json:"points_earned"`
+	PointsSpent    int                `json:"points_spent"`
+	Points         int                `json:"points"`
+	Streak         int                `json:"streak"`
+	Hours          float64            `json:"hours"`
+	XPPerHour      float64            `json:"xp_per_hour"`
+	Seed           int64              `json:"seed"`
+}
+
+// TaskStats is how often a task came up during the simulation and what it gave
+type TaskStats struct {
+	Task string `json:"task"`
+	// AssignmentChance is the chance of the task at the starting level, after blocks and unlocks
+	AssignmentChance float64 `json:"assignment_chance"`
+	// ExpectedQuantity is the average kills per assignment, including extensions
+	ExpectedQuantity float64 `json:"expected_quantity"`
+	Assigned         int     `json:"assigned"`
+	Skipped          int     `json:"skipped"`
+	Completed        int     `json:"completed"`
+	Kills            int     `json:"kills"`
+	SlayerXP         float64 `json:"slayer_xp"`
+	Hours            float64 `json:"hours"`
+}
+
+func CalculateSlayerData(input SlayerInput) (SlayerResult, error) {
+	return CalculateSlayerDataWithSeed(input, time.Now().UnixNano())
+}
+
+// CalculateSlayerDataWithSeed simulates Slayer tasks with a specific seed so results can be reproduced.
+// It runs input.Tasks tasks, or until input.TargetLevel when no task count is given.
+func CalculateSlayerDataWithSeed(input SlayerInput, seed int64) (SlayerResult, error) {
+	master, err := validateInput(input)
+	if err != nil {
+		return SlayerResult{}, err
+	}
+
+	settings := newTaskSettings(input)
+	startXP := xp.ForLevel(input.CurrentLevel)
+	targetXP := 0
+	if input.TargetLevel > 0 {
+		targetXP = xp.ForLevel(input.TargetLevel)
+	}
+
+	startChances := AssignmentChances(master, input.CurrentLevel, settings)
+	if len(startChances) == 0 {
+		return SlayerResult{}, fmt.Errorf("%s has no tasks available at level %d", master.Name, input.CurrentLevel)
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	stats := make(map[string]*TaskStats)
+	statFor := func(task string) *TaskStats {
+		if stats[task] == nil {
+			stats[task] = &TaskStats{Task: task}
+		}
+		return stats[task]
+	}
+
+	slayerXP := 0.0
+	totalKills, completed, skipped := 0, 0, 0
+	points, pointsEarned, pointsSpent := input.StartingPoints, 0, 0
+	streak := input.StartingStreak
+	hours := 0.0
+
+	done := func() bool {
+		if input.Tasks > 0 {
+			return completed >= input.Tasks
+		}
+		return float64(startXP)+slayerXP >= float64(targetXP)
+	}
+
+	for !done() {
+		if completed+skipped >= MaxSimulatedTasks {
+			return SlayerResult{}, fmt.Errorf("simulation stopped after %d assignments including skips", MaxSimulatedTasks)
+		}
+
+		level := xp.LevelForXP(startXP + int(slayerXP))
+		available := eligibleAssignments(master, level, settings)
+
+		assignment := pickAssignment(r, available)
+		stat := statFor(assignment.Task)
+		stat.Assigned++
+
+		// Tasks outside the preferred list are skipped while points allow it
+		if len(settings.preferred) > 0 && !settings.preferred[assignment.Task] && points >= SkipCost {
+			points -= SkipCost
+			pointsSpent += SkipCost
+			stat.Skipped++
+			skipped++
+			continue
+		}
+
+		monster := Monsters[assignment.Task]
+		low, high := quantityRange(assignment, monster, settings)
+		kills := low + r.Intn(high-low+1)
+		taskXP := float64(kills) * monster.HP
+		taskHours := float64(kills)/monster.KillsPerHour + TaskOverheadMinutes/60
+
+		stat.Completed++
+		stat.Kills += kills
+		stat.SlayerXP += taskXP
+		stat.Hours += taskHours
+
+		completed++
+		streak++
+		totalKills += kills
+		slayerXP += taskXP
+		hours += taskHours
+
+		earned := TaskPoints(master, streak)
+		points = min(points+earned, MaxPoints)
+		pointsEarned += earned
+	}
+
+	tasks := make([]TaskStats, 0, len(stats))
+	for _, assignment := range master.Assignments {
+		chance, available := startChances[assignment.Task]
+		stat := stats[assignment.Task]
+		if !available && stat == nil {
+			continue
+		}
+		if stat == nil {
+			stat = &TaskStats{Task: assignment.Task}
+		}
+
+		low, high := quantityRange(assignment, Monsters[assignment.Task], settings)
+		stat.AssignmentChance = chance
+		stat.ExpectedQuantity = float64(low+high) / 2
+		tasks = append(tasks, *stat)
+	}
+
+	combatXP := make(map[string]float64)
+	for skill, perDamage := range CombatXPPerDamage[CombatStyle(input.CombatStyle)] {
+		combatXP[skill] = slayerXP * perDamage
+	}
+
+	xpPerHour := 0.0
+	if hours > 0 {
+		xpPerHour = slayerXP / hours
+	}
+
+	return SlayerResult{
+		Master:         master.Name,
+		CombatStyle:    input.CombatStyle,
+		CurrentLevel:   input.CurrentLevel,
+		TargetLevel:    input.TargetLevel,
+		FinalLevel:     xp.VirtualLevelForXP(startXP + int(slayerXP)),
+		TasksCompleted: completed,
+		TasksSkipped:   skipped,
+		Tasks:          tasks,
+		TotalKills:     totalKills,
+		SlayerXP:       slayerXP,
+		CombatXP:       combatXP,
+		PointsEarned:   pointsEarned,
+		PointsSpent:    pointsSpent,
+		Points:         points,
+		Streak:         streak,
+		Hours:          hours,
+		XPPerHour:      xpPerHour,
+		Seed:           seed,
+	}, nil
+}
+
+// taskSettings holds the player's rewards as sets for quick lookups
+type taskSettings struct {
+	unlocks    map[string]bool
+	extensions map[string]bool
+	blocked    map[string]bool
+	preferred  map[string]bool
+}
+
+func newTaskSettings(input SlayerInput) taskSettings {
+	return taskSettings{
+		unlocks:    toSet(input.Unlocks),
+		extensions: toSet(input.Extensions),
+		blocked:    toSet(input.Blocked),
+		preferred:  toSet(input.Preferred),
+	}
+}
+
+func toSet(names []string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		set[name] = true
+	}
+	return set
+}
+
+// validateInput checks the input and returns the chosen master
+func validateInput(input SlayerInput) (Master, error) {
+	master, exists := Masters[input.Master]
+	if !exists {
+		return Master{}, fmt.Errorf("invalid slayer master: %s", input.Master)
+	}
+	if input.CombatLevel < master.CombatLevel {
+		return Master{}, fmt.Errorf("%s requires combat level %d", master.Name, master.CombatLevel)
+	}
+	if input.CurrentLevel < 1 || input.CurrentLevel > xp.MaxLevel {
+		return Master{}, fmt.Errorf("slayer level must be between 1 and %d", xp.MaxLevel)
+	}
+	if input.CurrentLevel < master.SlayerLevel {
+		return Master{}, fmt.Errorf("%s requires %d Slayer", master.Name, master.SlayerLevel)
+	}
+
+	switch {
+	case input.Tasks > 0 && input.TargetLevel > 0:
+		return Master{}, fmt.Errorf("give either a number of tasks or a target level, not both")
+	case input.Tasks > MaxSimulatedTasks:
+		return Master{}, fmt.Errorf("tasks must be at most %d", MaxSimulatedTasks)
+	case input.Tasks > 0:
+	case input.TargetLevel > 0:
+		if input.TargetLevel <= input.CurrentLevel {
+			return Master{}, fmt.Errorf("target level must be greater than current level")
+		}
+		if input.TargetLevel > xp.MaxVirtualLevel {
+			return Master{}, fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+		}
+	default:
+		return Master{}, fmt.Errorf("either tasks or target level must be provided")
+	}
+
+	if _, exists := CombatXPPerDamage[CombatStyle(input.CombatStyle)]; !exists {
+		return Master{}, fmt.Errorf("invalid combat style: %s", input.CombatStyle)
+	}
+
+	if len(input.Blocked) > MaxBlocks {
+		return Master{}, fmt.Errorf("at most %d tasks can be blocked", MaxBlocks)
+	}
+	for _, task := range slices.Concat(input.Blocked, input.Preferred) {
+		if _, exists := Monsters[task]; !exists {
+			return Master{}, fmt.Errorf("invalid task: %s", task)
+		}
+	}
+	for _, unlock := range input.Unlocks {
+		if !isReward(unlock, func(m Monster) string { return m.Unlock }) {
+			return Master{}, fmt.Errorf("invalid unlock: %s", unlock)
+		}
+	}
+	for _, extension := range input.Extensions {
+		if !isReward(extension, func(m Monster) string { return m.Extension }) {
+			return Master{}, fmt.Errorf("invalid extension: %s", extension)
+		}
+	}
+
+	if input.StartingPoints < 0 || input.StartingStreak < 0 {
+		return Master{}, fmt.Errorf("starting points and streak must not be negative")
+	}
+	if input.StartingPoints > MaxPoints {
+		return Master{}, fmt.Errorf("starting points must be at most %d", MaxPoints)
+	}
+	return master, nil
+}
+
+// isReward reports whether any monster has the named reward
+func isReward(name string, reward func(Monster) string) bool {
+	for _, monster := range Monsters {
+		if reward(monster) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// eligibleAssignments returns the master's tasks that can be assigned at a Slayer level
+func eligibleAssignments(master Master, level int, settings taskSettings) []Assignment {
+	var available []Assignment
+	for _, assignment := range master.Assignments {
+		monster := Monsters[assignment.Task]
+		if level < monster.SlayerLevel || settings.blocked[assignment.Task] {
+			continue
+		}
+		if monster.Unlock != "" && !settings.unlocks[monster.Unlock] {
+			continue
+		}
+		available = append(available, assignment)
+	}
+	return available
+}
+
+// AssignmentChances returns the chance of each available task from a master at a Slayer level
+func AssignmentChances(master Master, level int, settings taskSettings) map[string]float64 {
+	available := eligibleAssignments(master, level, settings)
+	totalWeight := 0
+	for _, assignment := range available {
+		totalWeight += assignment.Weight
+	}
+
+	chances := make(map[string]float64, len(available))
+	for _, assignment := range available {
+		chances[assignment.Task] = float64(assignment.Weight) / float64(totalWeight)
+	}
+	return chances
+}
+
+// pickAssignment picks a task in proportion to its weight
+func pickAssignment(r *rand.Rand, available []Assignment) Assignment {
+	totalWeight := 0
+	for _, assignment := range available {
+		totalWeight += assignment.Weight
+	}
+
+	roll := r.Intn(totalWeight)
+	for _, assignment := range available {
+		if roll < assignment.Weight {
+			return assignment
+		}
+		roll -= assignment.Weight
+	}
+	return available[len(available)-1]
+}
+
+// quantityRange returns the kills range of an assignment, using the extended range when bought
+func quantityRange(assignment Assignment, monster Monster, settings taskSettings) (int, int) {
+	if assignment.ExtendedMax > 0 && settings.extensions[monster.Extension] {
+		return assignment.ExtendedMin, assignment.ExtendedMax
+	}
+	return assignment.Min, assignment.Max
+}
+
+// TaskPoints returns the points for completing a task at the given streak
+func TaskPoints(master Master, streak int) int {
+	for _, milestone := range streakMilestones {
+		if streak%milestone.Every == 0 {
+			return master.PointsPerTask * milestone.Multiplier
+		}
+	}
+	return master.PointsPerTask
+}
+
+// GetCalculationProTips provides detailed information about how Slayer calculations work
+func GetCalculationProTips() map[string]any {
+	return map[string]any{
+		"calculation_methodology": map[string]any{
+			"xp_rates_source": "Task weights, quantities and monster Hitpoints based on the OSRS Wiki",
+			"base_formula":    "Slayer XP per task = kills × monster Hitpoints, with tasks drawn by weight from the master's list",
+			"data_points": []map[string]any{
+				{"master": "vannaka", "level": 50, "xp_per_hour": 12000, "note": "No rewards bought"},
+				{"master": "nieve", "level": 85, "xp_per_hour": 20000, "note": "Abyssal demon extension"},
+				{"master": "duradel", "level": 95, "xp_per_hour": 22500, "note": "Abyssal demon and dark beast extensions, kalphite and trolls blocked"},
+			},
+		},
+		"game_mechanics": map[string]any{
+			"task_assignment": "Each task is drawn in proportion to its weight among the tasks the player can receive",
+			"points":          "Points are earned per task, multiplied on every 10th, 50th, 100th, 250th and 1000th task in a row",
+			"rewards": []string{
+				"Blocking removes a task from the master's list",
+				"Unlocks add tasks such as red dragons and aviansies",
+				"Extensions raise the number of kills per task",
+				"Skipping a task costs 30 points and keeps the streak",
+			},
+		},
+		"factors_considered": []string{
+			"Slayer level (affects the tasks that can be assigned)",
+			"Blocked, unlocked and extended tasks",
+			"Preferred tasks, skipping the rest while points allow",
+			"Combat style for the combat XP earned alongside Slayer XP",
+		},
+		"accuracy_notes": map[string]any{
+			"rates_vary": "Results vary between simulations and with the player's gear:",
+			"variance_factors": []string{
+				"Kills per hour depend heavily on gear and cannons",
+				"Travel and banking time between tasks",
+				"Random task order over short simulations",
+			},
+			"calculation_basis": "A seeded simulation of the chosen number of tasks",
+		},
+		"pro_tips": []map[string]string{
+			{
+				"tip":         "Turael Boosting",
+				"description": "Complete tasks from Turael between milestones and take the 10th task from your best master",
+			},
+			{
+				"tip":         "Extend the Good Tasks",
+				"description": "Extend fast tasks like abyssal demons and dust devils for more XP per point spent",
+			},
+			{
+				"tip":         "Block the Slow Tasks",
+				"description": "Use blocks on the slowest tasks you get most often",
+			},
+		},
+		"reward_calculation": map[string]any{
+			"points_per_task": "Master's points per task, × 5, 15, 25, 35 or 50 on streak milestones",
+			"combat_xp":       "4 XP per damage in the chosen style (2 for magic, 1.33 each for controlled) plus 1.33 Hitpoints XP",
+			"time":            "Kills ÷ kills per hour plus a fixed overhead per task",
+		},
+	}
+}
@@ -0,0 +1,213 @@
+package slayer
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCalculateSlayerData(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       SlayerInput
+		expectError bool
+	}{
+		{
+			name:  "Vannaka tasks",
+			input: SlayerInput{Master: "vannaka", CombatLevel: 60, CurrentLevel: 40, Tasks: 50, CombatStyle: "controlled"},
+		},
+		{
+			name: "Duradel to 99 with rewards",
+			input: SlayerInput{
+				Master: "duradel", CombatLevel: 120, CurrentLevel: 90, TargetLevel: 99, CombatStyle: "ranged",
+				Unlocks:    []string{"Seeing red"},
+				Extensions: []string{"Augment my abbies", "Need more darkness"},
+				Blocked:    []string{"Kalphite", "Trolls"},
+			},
+		},
+		{
+			name:        "Combat level too low for the master",
+			input:       SlayerInput{Master: "nieve", CombatLevel: 80, CurrentLevel: 70, Tasks: 10, CombatStyle: "strength"},
+			expectError: true,
+		},
+		{
+			name:        "Slayer level too low for Duradel",
+			input:       SlayerInput{Master: "duradel", CombatLevel: 110, CurrentLevel: 40, Tasks: 10, CombatStyle: "strength"},
+			expectError: true,
+		},
+		{
+			name:        "Both tasks and target level",
+			input:       SlayerInput{Master: "nieve", CombatLevel: 100, CurrentLevel: 70, Tasks: 10, TargetLevel: 80, CombatStyle: "strength"},
+			expectError: true,
+		},
+		{
+			name: "Too many blocks",
+			input: SlayerInput{
+				Master: "nieve", CombatLevel: 100, CurrentLevel: 70, Tasks: 10, CombatStyle: "strength",
+				Blocked: []string{"Kalphite", "Trolls", "Hellhounds", "Fire giants", "Bloodveld", "Black demons", "Gargoyles"},
+			},
+			expectError: true,
+		},
+		{
+			name:        "Invalid combat style",
+			input:       SlayerInput{Master: "nieve", CombatLevel: 100, CurrentLevel: 70, Tasks: 10, CombatStyle: "prayer"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculateSlayerDataWithSeed(tt.input, 42)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if tt.input.Tasks > 0 && result.TasksCompleted != tt.input.Tasks {
+				t.Errorf("Expected %d tasks, got %d", tt.input.Tasks, result.TasksCompleted)
+			}
+			if tt.input.TargetLevel > 0 && result.FinalLevel < tt.input.TargetLevel {
+				t.Errorf("Expected to reach level %d, got %d", tt.input.TargetLevel, result.FinalLevel)
+			}
+			if result.SlayerXP <= 0 || result.Hours <= 0 || result.TotalKills <= 0 {
+				t.Errorf("Expected positive XP, hours and kills, got %f, %f, %d", result.SlayerXP, result.Hours, result.TotalKills)
+			}
+
+			totalChance, completed := 0.0, 0
+			for _, task := range result.Tasks {
+				totalChance += task.AssignmentChance
+				completed += task.Completed
+			}
+			if math.Abs(totalChance-1) > 1e-9 {
+				t.Errorf("Expected assignment chances to sum to 1, got %f", totalChance)
+			}
+			if completed != result.TasksCompleted {
+				t.Errorf("Expected task completions to sum to %d, got %d", result.TasksCompleted, completed)
+			}
+		})
+	}
+}
+
+func TestBlockedAndLockedTasks(t *testing.T) {
+	input := SlayerInput{
+		Master: "duradel", CombatLevel: 120, CurrentLevel: 85, Tasks: 20, CombatStyle: "strength",
+		Blocked: []string{"Kalphite"},
+	}
+	result, err := CalculateSlayerDataWithSeed(input, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, task := range result.Tasks {
+		switch task.Task {
+		case "Kalphite", "Red dragons", "Aviansies", "Dark beasts", "Smoke devils":
+			t.Errorf("Expected %s to never be assigned", task.Task)
+		}
+	}
+}
+
+func TestPreferredTasksAreKept(t *testing.T) {
+	input := SlayerInput{
+		Master: "duradel", CombatLevel: 120, CurrentLevel: 95, Tasks: 100, CombatStyle: "strength",
+		Preferred:      []string{"Abyssal demons", "Dust devils", "Nechryael"},
+		StartingPoints: MaxPoints,
+	}
+	result, err := CalculateSlayerDataWithSeed(input, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, task := range result.Tasks {
+		if task.Completed > 0 && !map[string]bool{"Abyssal demons": true, "Dust devils": true, "Nechryael": true}[task.Task] {
+			t.Errorf("Expected %s to be skipped, completed %d times", task.Task, task.Completed)
+		}
+	}
+	if result.PointsSpent != result.TasksSkipped*SkipCost {
+		t.Errorf("Expected %d points spent, got %d", result.TasksSkipped*SkipCost, result.PointsSpent)
+	}
+}
+
+func TestSkipsCountTowardTaskLimit(t *testing.T) {
+	input := SlayerInput{
+		Master: "duradel", CombatLevel: 120, CurrentLevel: 95, Tasks: 1, CombatStyle: "strength",
+		Preferred:      []string{"Kurask"},
+		StartingPoints: MaxPoints + 1,
+	}
+	if _, err := CalculateSlayerDataWithSeed(input, 1); err == nil {
+		t.Errorf("Expected error for starting points above %d", MaxPoints)
+	}
+
+	input.StartingPoints = MaxPoints
+	start := time.Now()
+	result, err := CalculateSlayerDataWithSeed(input, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Skipping should stay bounded, took %v", elapsed)
+	}
+	if result.TasksCompleted+result.TasksSkipped > MaxSimulatedTasks {
+		t.Errorf("Expected at most %d assignments, got %d", MaxSimulatedTasks, result.TasksCompleted+result.TasksSkipped)
+	}
+}
+
+func TestExtensionsRaiseQuantity(t *testing.T) {
+	base := SlayerInput{Master: "nieve", CombatLevel: 100, CurrentLevel: 90, Tasks: 10, CombatStyle: "strength"}
+	extended := base
+	extended.Extensions = []string{"Need more darkness"}
+
+	quantity := func(input SlayerInput) float64 {
+		result, err := CalculateSlayerDataWithSeed(input, 1)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		for _, task := range result.Tasks {
+			if task.Task == "Dark beasts" {
+				return task.ExpectedQuantity
+			}
+		}
+		t.Fatalf("Expected dark beasts in the task list")
+		return 0
+	}
+
+	if quantity(extended) <= quantity(base) {
+		t.Errorf("Expected the extension to raise the dark beast quantity")
+	}
+}
+
+func TestTaskPoints(t *testing.T) {
+	master := Masters["duradel"]
+	tests := map[int]int{1: 15, 10: 75, 50: 225, 100: 375, 250: 525, 1000: 750, 1001: 15}
+	for streak, expected := range tests {
+		if points := TaskPoints(master, streak); points != expected {
+			t.Errorf("Streak %d: expected %d points, got %d", streak, expected, points)
+		}
+	}
+}
+
+func TestCalculateSlayerDataWithSeed(t *testing.T) {
+	input := SlayerInput{Master: "chaeldar", CombatLevel: 80, CurrentLevel: 70, Tasks: 30, CombatStyle: "magic"}
+	first, err := CalculateSlayerDataWithSeed(input, 42)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	second, err := CalculateSlayerDataWithSeed(input, 42)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("Expected the same seed to give the same result")
+	}
+	if first.CombatXP["magic"] != first.SlayerXP*2 {
+		t.Errorf("Expected 2 magic XP per Slayer XP, got %f", first.CombatXP["magic"]/first.SlayerXP)
+	}
+}
@@ -0,0 +1,61 @@
+// Package technique wires every technique calculator into a single registry.
+// Adding a calculator means adding its package and one Register line here.
+package technique
+
+import (
+	"osrs-xp-kits/internal/calculators"
+	ardyknights "osrs-xp-kits/internal/calculators/technique/ardy_knights"
+	"osrs-xp-kits/internal/calculators/technique/birdhouses"
+	blastfurnace "osrs-xp-kits/internal/calculators/technique/blast_furnace"
+	"osrs-xp-kits/internal/calculators/technique/chinchompas"
+	"osrs-xp-kits/internal/calculators/technique/fishing"
+	giantsfoundry "osrs-xp-kits/internal/calculators/technique/giants_foundry"
+	"osrs-xp-kits/internal/calculators/technique/gotr"
+	herbruns "osrs-xp-kits/internal/calculators/technique/herb_runs"
+	"osrs-xp-kits/internal/calculators/technique/herbiboar"
+	"osrs-xp-kits/internal/calculators/technique/herblore"
+	mahoganyhomes "osrs-xp-kits/internal/calculators/technique/mahogany_homes"
+	"osrs-xp-kits/internal/calculators/technique/motherlode"
+	"osrs-xp-kits/internal/calculators/technique/prayer"
+	pyramidplunder "osrs-xp-kits/internal/calculators/technique/pyramid_plunder"
+	"osrs-xp-kits/internal/calculators/technique/rooftops"
+	"osrs-xp-kits/internal/calculators/technique/runecrafting"
+	"osrs-xp-kits/internal/calculators/technique/sepulchre"
+	"osrs-xp-kits/internal/calculators/technique/slayer"
+	"osrs-xp-kits/internal/calculators/technique/tempoross"
+	treeruns "osrs-xp-kits/internal/calculators/technique/tree_runs"
+	volcanicmine "osrs-xp-kits/internal/calculators/technique/volcanic_mine"
+	"osrs-xp-kits/internal/calculators/technique/wintertodt"
+	"osrs-xp-kits/internal/calculators/technique/zalcano"
+)
+
+// NewRegistry returns a registry containing every technique calculator
+func NewRegistry() *calculators.Registry {
+	r := calculators.NewRegistry()
+
+	calculators.MustRegister(r, ardyknights.Calculator{})
+	calculators.MustRegister(r, birdhouses.Calculator{})
+	calculators.MustRegister(r, blastfurnace.Calculator{})
+	calculators.MustRegister(r, chinchompas.Calculator{})
+	calculators.MustRegister(r, fishing.Calculator{})
+	calculators.MustRegister(r, giantsfoundry.Calculator{})
+	calculators.MustRegister(r, gotr.Calculator{})
+	calculators.MustRegister(r, herbiboar.Calculator{})
+	calculators.MustRegister(r, herblore.Calculator{})
+	calculators.MustRegister(r, herbruns.Calculator{})
+	calculators.MustRegister(r, mahoganyhomes.Calculator{})
+	calculators.MustRegister(r, motherlode.Calculator{})
+	calculators.MustRegister(r, prayer.Calculator{})
+	calculators.MustRegister(r, pyramidplunder.Calculator{})
+	calculators.MustRegister(r, rooftops.Calculator{})
+	calculators.MustRegister(r, runecrafting.Calculator{})
+	calculators.MustRegister(r, sepulchre.Calculator{})
+	calculators.MustRegister(r, slayer.Calculator{})
+	calculators.MustRegister(r, tempoross.Calculator{})
+	calculators.MustRegister(r, treeruns.Calculator{})
+	calculators.MustRegister(r, volcanicmine.Calculator{})
+	calculators.MustRegister(r, wintertodt.Calculator{})
+	calculators.MustRegister(r, zalcano.Calculator{})
+
+	return r
+}
@@ -0,0 +1,80 @@
+package tempoross
+
+import (
+	"fmt"
+
+	"osrs-xp-kits/internal/calculators"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+// TemporossInput is the request body accepted by the Tempoross calculator
+type TemporossInput struct {
+	CurrentLevel         int      `json:"current_level"`
+	TargetLevel          int      `json:"target_level"`
+	FishType             string   `json:"fish_type"`
+	CustomPointsPerGame  *int     `json:"custom_points_per_game,omitempty"`
+	CustomMinutesPerGame *float64 `json:"custom_minutes_per_game,omitempty"`
+}
+
+// Calculator exposes Tempoross through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the Tempoross calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "tempoross",
+		Name:        "Tempoross",
+		Description: "Fishing XP, reward permits, reward pool loot and Tiny tempor pet odds at Tempoross",
+		Category:    "minigame",
+		Skills:      []string{"fishing"},
+	}
+}
+
+// Validate checks the Tempoross input
+func (Calculator) Validate(input TemporossInput) error {
+	if input.CurrentLevel < MinimumFishingLevel {
+		return fmt.Errorf("fishing level must be at least %d", MinimumFishingLevel)
+	}
+	if input.TargetLevel < input.CurrentLevel {
+		return fmt.Errorf("target level must be greater than or equal to current level")
+	}
+	if input.TargetLevel > xp.MaxVirtualLevel {
+		return fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+	if _, exists := FishTypeData[FishType(input.FishType)]; !exists {
+		return fmt.Errorf("invalid fish type: %s", input.FishType)
+	}
+	if input.CustomPointsPerGame != nil {
+		return validatePointsPerGame(*input.CustomPointsPerGame)
+	}
+	return nil
+}
+
+// Calculate runs the Tempoross calculation
+func (Calculator) Calculate(input TemporossInput, opts calculators.Options) (TemporossResult, error) {
+	result, err := CalculateTemporossDataWithSeed(
+		input.CurrentLevel,
+		input.TargetLevel,
+		FishType(input.FishType),
+		input.CustomPointsPerGame,
+		input.CustomMinutesPerGame,
+		opts.Prices,
+		opts.Seed,
+	)
+	if err != nil || opts.Simulations == 0 {
+		return result, err
+	}
+
+	distribution, err := SimulateRewardPoolDistribution(opts.RequestContext(), result.TotalPermits, opts.Prices, opts.Simulations, opts.Seed)
+	if err != nil {
+		return TemporossResult{}, err
+	}
+	result.Distribution = &distribution
+
+	return result, nil
+}
+
+// ProTips returns the Tempoross calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
@@ -0,0 +1,83 @@
+package tempoross
+
+import "osrs-xp-kits/internal/calculators/pets"
+
+// TinyTempor is rolled once per reward permit, with better odds at higher Fishing levels
+var TinyTempor = pets.Pet{Name: "Tiny tempor", BaseRate: 8000}
+
+type LootItem struct {
+	Name     string  `json:"name"`
+	Quantity int     `json:"quantity"`
+	Value    int     `json:"value"`
+	Rate     float64 `json:"rate"`
+}
+
+// Unique items rolled independently on every reward permit.
+// The Tiny tempor is not listed: its chance scales with Fishing level, see TinyTempor and PetOdds.
+var UniqueRolls = []LootItem{
+	{"Fish barrel", 1, 0, 1.0 / 400.0},        // 1/400 (untradeable)
+	{"Tackle box", 1, 0, 1.0 / 400.0},         // 1/400 (untradeable)
+	{"Big harpoonfish", 1, 0, 1.0 / 1600.0},   // 1/1,600 (untradeable)
+	{"Tome of water", 1, 90000, 1.0 / 1600.0}, // 1/1,600
+	{"Dragon harpoon", 1, 0, 1.0 / 8000.0},    // 1/8,000 (untradeable)
+}
+
+// Regular reward pool drops, one per permit (first hit wins)
+var RewardPoolDrops = []LootItem{
+	{"Spirit flakes", 32, 60, 0.2},
+	{"Soaked page", 8, 0, 0.15},
+	{"Raw tuna", 25, 90, 0.12},
+	{"Raw swordfish", 20, 250, 0.1},
+	{"Raw shark", 10, 800, 0.08},
+	{"Feather", 60, 3, 0.12},
+	{"Plank", 15, 300, 0.08},
+	{"Raw harpoonfish", 10, 550, 0.1},
+}
+
+// Experience and reward constants based on OSRS Wiki
+const (
+	MinimumFishingLevel = 35
+
+	// HarpoonfishXP is the Fishing XP for catching one harpoonfish
+	HarpoonfishXP = 65.0
+	// BonusXPPerPointPerLevel is the Fishing XP per point scored, multiplied by the Fishing level
+	BonusXPPerPointPerLevel = 0.015
+
+	// Reward permits: one at 2,000 points and one more for every 700 points after that
+	PointsForFirstPermit = 2000
+	PointsPerExtraPermit = 700
+
+	// BasePointsPerGame covers attacking the spirit pool, tethering and repairs
+	BasePointsPerGame = 2500
+	// MaxPointsPerGame bounds custom points per game, well above the best solo games
+	MaxPointsPerGame = 10000
+)
+
+// FishType is how harpoonfish are loaded into the cannons
+type FishType string
+
+const (
+	FishTypeRaw    FishType = "raw"
+	FishTypeCooked FishType = "cooked"
+)
+
+// Fish type data for catches, points and time per game
+var FishTypeData = map[FishType]struct {
+	FishPerGame    float64
+	PointsPerFish  int
+	MinutesPerGame float64
+	Description    string
+}{
+	FishTypeRaw: {
+		FishPerGame:    120,
+		PointsPerFish:  10,
+		MinutesPerGame: 10.0,
+		Description:    "Load raw harpoonfish for the most fish caught and the best Fishing XP",
+	},
+	FishTypeCooked: {
+		FishPerGame:    90,
+		PointsPerFish:  20,
+		MinutesPerGame: 11.0,
+		Description:    "Cook harpoonfish before loading them for more points and reward permits",
+	},
+}
@@ -0,0 +1,115 @@
+package tempoross
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"osrs-xp-kits/internal/calculators/tools"
+)
+
+// rollsPerPermit is the RNG work of one permit: every unique plus one regular drop
+var rollsPerPermit = len(UniqueRolls) + 1
+
+// SimulateRewardPool simulates the reward pool loot for the given number of permits
+func SimulateRewardPool(permits int, livePrices map[string]int) (map[string]any, int) {
+	return SimulateRewardPoolWithSeed(permits, livePrices, time.Now().UnixNano())
+}
+
+// SimulateRewardPoolWithSeed simulates the reward pool loot with a specific seed so results can be reproduced
+func SimulateRewardPoolWithSeed(permits int, livePrices map[string]int, seed int64) (map[string]any, int) {
+	r := rand.New(rand.NewSource(seed))
+
+	lootCounts := make(map[string]int)
+	totalValue := 0
+
+	uniques := withLivePrices(UniqueRolls, livePrices)
+	drops := withLivePrices(RewardPoolDrops, livePrices)
+
+	for range permits {
+		// Every unique is rolled on each permit
+		for _, unique := range uniques {
+			if r.Float64() < unique.Rate {
+				lootCounts[unique.Name] += unique.Quantity
+				totalValue += unique.Quantity * unique.Value
+			}
+		}
+
+		// Then one regular drop
+		for _, item := range drops {
+			if r.Float64() < item.Rate {
+				lootCounts[item.Name] += item.Quantity
+				totalValue += item.Quantity * item.Value
+				break // Only one item per permit
+			}
+		}
+	}
+
+	// Format loot for response
+	loot := make(map[string]any)
+	for name, quantity := range lootCounts {
+		if quantity > 0 {
+			loot[name] = quantity
+		}
+	}
+
+	return loot, totalValue
+}
+
+// SimulateRewardPoolDistribution runs the reward pool simulation for many independent trials and
+// summarises the total value and the count of every unique reward
+func SimulateRewardPoolDistribution(ctx context.Context, permits int, livePrices map[string]int, trials int, seed int64) (tools.Distribution, error) {
+	return tools.SimulateDistribution(ctx, trials, permits*rollsPerPermit, seed, func(trialSeed int64) tools.Trial {
+		loot, totalValue := SimulateRewardPoolWithSeed(permits, livePrices, trialSeed)
+
+		items := make(map[string]int, len(UniqueRolls))
+		for _, unique := range UniqueRolls {
+			quantity, _ := loot[unique.Name].(int)
+			items[unique.Name] = quantity
+		}
+
+		return tools.Trial{TotalValue: totalValue, Items: items}
+	})
+}
+
+// CalculateExpectedLoot returns the exact expected reward pool loot for the given permits, without any RNG
+func CalculateExpectedLoot(permits int, livePrices map[string]int) tools.ExpectedLoot {
+	uniques := dropTable(withLivePrices(UniqueRolls, livePrices), false).ExpectedIndependent(permits)
+	drops := dropTable(withLivePrices(RewardPoolDrops, livePrices), true).Expected(permits)
+
+	return tools.MergeExpected(uniques, drops)
+}
+
+// dropTable converts loot items into a drop table. For exclusive tables the first hit wins,
+// so each item's chance is its rate times the chance that every earlier item missed.
+func dropTable(items []LootItem, exclusive bool) tools.DropTable {
+	table := make(tools.DropTable, 0, len(items))
+	missedSoFar := 1.0
+	for _, item := range items {
+		table = append(table, tools.DropItem{
+			Name:        item.Name,
+			Probability: item.Rate * missedSoFar,
+			Price:       item.Value,
+			MinQuantity: item.Quantity,
+			MaxQuantity: item.Quantity,
+		})
+		if exclusive {
+			missedSoFar *= 1 - item.Rate
+		}
+	}
+	return table
+}
+
+// withLivePrices returns a copy of items with their values replaced by live prices where available
+func withLivePrices(items []LootItem, livePrices map[string]int) []LootItem {
+	priced := make([]LootItem, len(items))
+	copy(priced, items)
+
+	for i, item := range priced {
+		if livePrice, exists := livePrices[item.Name]; exists {
+			priced[i].Value = livePrice
+		}
+	}
+
+	return priced
+}
@@ -0,0 +1,279 @@
+package tempoross
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"osrs-xp-kits/internal/calculators/pets"
+	"osrs-xp-kits/internal/calculators/probability"
+	"osrs-xp-kits/internal/calculators/progression"
+	"osrs-xp-kits/internal/calculators/tools"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+type TemporossResult struct {
+	CurrentLevel      int            `json:"current_level"`
+	TargetLevel       int            `json:"target_level"`
+	XpNeeded          int            `json:"xp_needed"`
+	GamesNeeded       int            `json:"games_needed"`
+	TotalExperience   int            `json:"total_experience"`
+	AverageExpHour    float64        `json:"average_exp_hour"`
+	PetChance         float64        `json:"pet_chance"`
+	EstimatedLoot     map[string]any `json:"estimated_loot"`
+	TotalValue        int            `json:"total_value"`
+	TotalTime         float64        `json:"total_time"`
+	FishType          string         `json:"fish_type"`
+	FishPerGame       float64        `json:"fish_per_game"`
+	PointsPerGame     int            `json:"points_per_game"`
+	PermitsPerGame    int            `json:"permits_per_game"`
+	TotalPermits      int            `json:"total_permits"`
+	MinutesPerGame    float64        `json:"minutes_per_game"`
+	TotalPointsEarned int            `json:"total_points_earned"`
+	Seed              int64          `json:"seed"`
+
+	Progression progression.Progression `json:"progression"`
+	UniqueOdds  []probability.DropOdds  `json:"unique_odds"`
+	PetOdds     pets.Odds               `json:"pet_odds"`
+
+	ExpectedLoot tools.ExpectedLoot `json:"expected_loot"`
+
+	Distribution *tools.Distribution `json:"distribution,omitempty"`
+}
+
+func CalculateTemporossData(currentLevel, targetLevel int, fishType FishType, customPointsPerGame *int, customMinutesPerGame *float64) (TemporossResult, error) {
+	return CalculateTemporossDataWithPrices(currentLevel, targetLevel, fishType, customPointsPerGame, customMinutesPerGame, nil)
+}
+
+// CalculateTemporossDataWithPrices calculates Tempoross data with optional live prices
+func CalculateTemporossDataWithPrices(currentLevel, targetLevel int, fishType FishType, customPointsPerGame *int, customMinutesPerGame *float64, livePrices map[string]int) (TemporossResult, error) {
+	return CalculateTemporossDataWithSeed(currentLevel, targetLevel, fishType, customPointsPerGame, customMinutesPerGame, livePrices, time.Now().UnixNano())
+}
+
+// CalculateTemporossDataWithSeed calculates Tempoross data with a specific loot seed so results can be reproduced
+func CalculateTemporossDataWithSeed(currentLevel, targetLevel int, fishType FishType, customPointsPerGame *int, customMinutesPerGame *float64, livePrices map[string]int, seed int64) (TemporossResult, error) {
+	if currentLevel < MinimumFishingLevel {
+		return TemporossResult{}, fmt.Errorf("fishing level must be at least %d", MinimumFishingLevel)
+	}
+
+	if targetLevel < currentLevel {
+		return TemporossResult{}, fmt.Errorf("target level must be greater than or equal to current level")
+	}
+
+	if targetLevel > xp.MaxVirtualLevel {
+		return TemporossResult{}, fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+
+	fishInfo, exists := FishTypeData[fishType]
+	if !exists {
+		return TemporossResult{}, fmt.Errorf("invalid fish type: %s", fishType)
+	}
+
+	pointsPerGame := BasePointsPerGame + int(fishInfo.FishPerGame)*fishInfo.PointsPerFish
+	minutesPerGame := fishInfo.MinutesPerGame
+
+	// Override with custom values if provided
+	if customPointsPerGame != nil {
+		if err := validatePointsPerGame(*customPointsPerGame); err != nil {
+			return TemporossResult{}, err
+		}
+		pointsPerGame = *customPointsPerGame
+	}
+	if customMinutesPerGame != nil {
+		minutesPerGame = *customMinutesPerGame
+	}
+	if minutesPerGame <= 0 {
+		return TemporossResult{}, fmt.Errorf("minutes per game must be positive")
+	}
+
+	// Calculate XP needed
+	currentXP := xp.ForLevel(currentLevel)
+	targetXP := xp.ForLevel(targetLevel)
+	xpNeeded := targetXP - currentXP
+
+	// Time calculations - add 1 minute buffer between games if not using custom time
+	effectiveMinutesPerGame := minutesPerGame
+	if customMinutesPerGame == nil {
+		effectiveMinutesPerGame += 1.0 // Add 1 minute buffer between games
+	}
+	gamesPerHour := 60.0 / effectiveMinutesPerGame
+
+	// Walk level by level so every game uses the XP of the level it is played at
+	levelProgression, err := progression.Walk(currentXP, targetXP, func(level int) progression.Rate {
+		return progression.Rate{
+			XPPerAction:    xpPerGame(level, fishInfo.FishPerGame, pointsPerGame),
+			ActionsPerHour: gamesPerHour,
+			SuccessChance:  1,
+		}
+	})
+	if err != nil {
+		return TemporossResult{}, err
+	}
+
+	// Calculate games needed
+	gamesNeeded := int(math.Ceil(levelProgression.TotalActions))
+	if gamesNeeded <= 0 {
+		gamesNeeded = 1 // Minimum 1 game for calculation purposes
+	}
+
+	// Calculate total experience (could be more than needed since the last game is played in full)
+	expPerGame := levelProgression.AverageXPPerAction()
+	if expPerGame == 0 {
+		expPerGame = xpPerGame(currentLevel, fishInfo.FishPerGame, pointsPerGame)
+	}
+	totalExp := int(math.Round(expPerGame * float64(gamesNeeded)))
+
+	totalTime := float64(gamesNeeded) / gamesPerHour // Hours
+	avgExpHour := levelProgression.AverageXPPerHour
+
+	// Reward permits
+	permitsPerGame := PermitsForPoints(pointsPerGame)
+	totalPermits := permitsPerGame * gamesNeeded
+	if err := tools.ValidateSimulationBudget(1, totalPermits*rollsPerPermit); err != nil {
+		return TemporossResult{}, err
+	}
+
+	// Pet chance calculation, one roll per reward permit at the level each game is played at
+	petOdds, err := TinyTempor.OverProgression(currentXP, levelProgression, float64(permitsPerGame))
+	if err != nil {
+		return TemporossResult{}, err
+	}
+
+	uniqueOdds, err := CalculateUniqueOdds(totalPermits, petOdds.EffectiveRate)
+	if err != nil {
+		return TemporossResult{}, err
+	}
+
+	// Reward pool simulation, using live prices if available
+	estimatedLoot, totalValue := SimulateRewardPoolWithSeed(totalPermits, livePrices, seed)
+
+	return TemporossResult{
+		CurrentLevel:      currentLevel,
+		TargetLevel:       targetLevel,
+		XpNeeded:          xpNeeded,
+		GamesNeeded:       gamesNeeded,
+		TotalExperience:   totalExp,
+		AverageExpHour:    avgExpHour,
+		PetChance:         petOdds.Chance * 100, // Convert to percentage
+		EstimatedLoot:     estimatedLoot,
+		TotalValue:        totalValue,
+		TotalTime:         totalTime,
+		FishType:          string(fishType),
+		FishPerGame:       fishInfo.FishPerGame,
+		PointsPerGame:     pointsPerGame,
+		PermitsPerGame:    permitsPerGame,
+		TotalPermits:      totalPermits,
+		MinutesPerGame:    minutesPerGame,
+		TotalPointsEarned: pointsPerGame * gamesNeeded,
+		Seed:              seed,
+		Progression:       levelProgression,
+		UniqueOdds:        uniqueOdds,
+		PetOdds:           petOdds,
+		ExpectedLoot:      CalculateExpectedLoot(totalPermits, livePrices),
+	}, nil
+}
+
+// validatePointsPerGame checks custom points per game, which drive the permits rolled on every request
+func validatePointsPerGame(points int) error {
+	if points <= 0 || points > MaxPointsPerGame {
+		return fmt.Errorf("points per game must be between 1 and %d", MaxPointsPerGame)
+	}
+	return nil
+}
+
+// PermitsForPoints returns the reward permits earned for a game's points
+func PermitsForPoints(points int) int {
+	if points < PointsForFirstPermit {
+		return 0
+	}
+	return 1 + (points-PointsForFirstPermit)/PointsPerExtraPermit
+}
+
+// xpPerGame returns the Fishing XP for one game at the given level.
+// Every harpoonfish caught gives flat XP and every point scored gives bonus XP scaling with level.
+func xpPerGame(level int, fishPerGame float64, pointsPerGame int) float64 {
+	fishXP := fishPerGame * HarpoonfishXP
+	bonusXP := float64(pointsPerGame) * float64(level) * BonusXPPerPointPerLevel
+
+	return math.Floor(fishXP + bonusXP)
+}
+
+// trackedUniques are the rare rewards reported with drop odds besides the pet, one roll per permit
+var trackedUniques = []string{"Fish barrel", "Tackle box", "Big harpoonfish", "Tome of water", "Dragon harpoon"}
+
+// CalculateUniqueOdds returns the odds of the Tiny tempor and each tracked unique over the given permits.
+// petRate is the pet chance per permit, which depends on the Fishing level.
+func CalculateUniqueOdds(permits int, petRate float64) ([]probability.DropOdds, error) {
+	uniques := []probability.Unique{{Name: TinyTempor.Name, Rate: petRate}}
+	for _, name := range trackedUniques {
+		for _, item := range UniqueRolls {
+			if item.Name == name {
+				uniques = append(uniques, probability.Unique{Name: item.Name, Rate: item.Rate})
+			}
+		}
+	}
+	return probability.UniqueOdds(uniques, permits)
+}
+
+// UniqueDropOdds returns the odds of the tracked uniques
+func (r TemporossResult) UniqueDropOdds() []probability.DropOdds {
+	return r.UniqueOdds
+}
+
+// GetCalculationProTips provides detailed information about how Tempoross calculations work
+func GetCalculationProTips() map[string]any {
+	return map[string]any{
+		"calculation_methodology": map[string]any{
+			"xp_rates_source": "Based on official Tempoross mechanics and community data",
+			"base_formula":    "Harpoonfish caught × 65 + points scored × Fishing level × 0.015, applied level by level",
+			"data_points": []map[string]any{
+				{"level": 35, "fish_type": "raw", "xp_per_hour": 53000, "note": "Minimum access level"},
+				{"level": 70, "fish_type": "raw", "xp_per_hour": 64000, "note": "Mid-level efficiency"},
+				{"level": 99, "fish_type": "raw", "xp_per_hour": 72000, "note": "Maximum rates"},
+				{"level": 99, "fish_type": "cooked", "xp_per_hour": 61000, "note": "Fewer fish, more permits"},
+			},
+		},
+		"game_mechanics": map[string]any{
+			"game_duration":  "8-12 minutes per game on a mass world",
+			"games_per_hour": "5-6 including the walk back to the boat",
+			"xp_sources": []string{
+				"Catching harpoonfish (primary source)",
+				"Bonus XP for every point scored",
+			},
+		},
+		"factors_considered": []string{
+			"Fishing level (affects bonus XP per point)",
+			"Raw or cooked harpoonfish (affects fish caught and points)",
+			"Reward permits from points per game",
+			"Level-scaled Tiny tempor pet chance per permit",
+		},
+		"accuracy_notes": map[string]any{
+			"rates_vary": "Individual XP rates can vary ±15% based on:",
+			"variance_factors": []string{
+				"World population and how quickly Tempoross is subdued",
+				"Double fishing spots",
+				"Time spent tethering and repairing",
+			},
+			"calculation_basis": "Rates assume a mass world with a consistent team",
+		},
+		"pro_tips": []map[string]string{
+			{
+				"tip":         "Cook for Permits",
+				"description": "Cooked harpoonfish score double points, giving extra permits at the cost of Fishing XP",
+			},
+			{
+				"tip":         "Double Spots",
+				"description": "Move to double fishing spots as soon as they appear to catch fish twice as fast",
+			},
+			{
+				"tip":         "Spirit Angler Outfit",
+				"description": "The outfit from the reward pool gives bonus Fishing XP and acts as a rope and hammer",
+			},
+		},
+		"reward_calculation": map[string]any{
+			"permits_per_game": "1 permit at 2,000 points, plus 1 for every 700 points after that",
+			"loot_simulation":  "Every permit rolls each unique independently, then one regular reward pool drop",
+			"gp_per_hour":      "Calculated from tradeable reward pool loot averaged over time",
+		},
+	}
+}
@@ -0,0 +1,186 @@
+package tempoross
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalculateTemporossData(t *testing.T) {
+	tests := []struct {
+		name         string
+		currentLevel int
+		targetLevel  int
+		fishType     FishType
+		expectError  bool
+	}{
+		{
+			name:         "Level 35 to 70 raw",
+			currentLevel: 35,
+			targetLevel:  70,
+			fishType:     FishTypeRaw,
+		},
+		{
+			name:         "Level 70 to 99 cooked",
+			currentLevel: 70,
+			targetLevel:  99,
+			fishType:     FishTypeCooked,
+		},
+		{
+			name:         "Invalid level (too low)",
+			currentLevel: 34,
+			targetLevel:  60,
+			fishType:     FishTypeRaw,
+			expectError:  true,
+		},
+		{
+			name:         "Target level lower than current",
+			currentLevel: 75,
+			targetLevel:  70,
+			fishType:     FishTypeRaw,
+			expectError:  true,
+		},
+		{
+			name:         "Invalid fish type",
+			currentLevel: 50,
+			targetLevel:  60,
+			fishType:     "fried",
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculateTemporossDataWithSeed(tt.currentLevel, tt.targetLevel, tt.fishType, nil, nil, nil, 42)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result.GamesNeeded <= 0 {
+				t.Errorf("Games needed should be positive, got %d", result.GamesNeeded)
+			}
+			if result.TotalExperience < result.XpNeeded {
+				t.Errorf("Total experience %d should cover the XP needed %d", result.TotalExperience, result.XpNeeded)
+			}
+			if result.TotalPermits != result.PermitsPerGame*result.GamesNeeded {
+				t.Errorf("Total permits %d should be permits per game times games", result.TotalPermits)
+			}
+			if result.PetChance <= 0 || result.PetChance >= 100 {
+				t.Errorf("Pet chance out of range: %f", result.PetChance)
+			}
+			if len(result.UniqueOdds) != len(trackedUniques)+1 {
+				t.Errorf("Expected odds for the pet and %d uniques, got %d", len(trackedUniques), len(result.UniqueOdds))
+			}
+		})
+	}
+}
+
+func TestCookedFishEarnMorePermits(t *testing.T) {
+	raw, err := CalculateTemporossDataWithSeed(70, 80, FishTypeRaw, nil, nil, nil, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	cooked, err := CalculateTemporossDataWithSeed(70, 80, FishTypeCooked, nil, nil, nil, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if cooked.PermitsPerGame <= raw.PermitsPerGame {
+		t.Errorf("Cooked fish should earn more permits per game: %d vs %d", cooked.PermitsPerGame, raw.PermitsPerGame)
+	}
+	if cooked.AverageExpHour >= raw.AverageExpHour {
+		t.Errorf("Raw fish should give more Fishing XP per hour: %.0f vs %.0f", raw.AverageExpHour, cooked.AverageExpHour)
+	}
+}
+
+func TestPermitsForPoints(t *testing.T) {
+	tests := []struct {
+		points int
+		want   int
+	}{
+		{1999, 0},
+		{2000, 1},
+		{2699, 1},
+		{2700, 2},
+		{4300, 4},
+	}
+
+	for _, tt := range tests {
+		if got := PermitsForPoints(tt.points); got != tt.want {
+			t.Errorf("PermitsForPoints(%d) = %d, want %d", tt.points, got, tt.want)
+		}
+	}
+}
+
+func TestRewardPoolMatchesExpectedLoot(t *testing.T) {
+	permits := 20000
+	loot, _ := SimulateRewardPoolWithSeed(permits, nil, 7)
+	expected := CalculateExpectedLoot(permits, nil)
+
+	for _, item := range expected.Items {
+		if item.Quantity < 100 {
+			continue // Too rare to compare against a single simulation
+		}
+		got, _ := loot[item.Name].(int)
+		if math.Abs(float64(got)-item.Quantity) > 5*item.StdDev {
+			t.Errorf("%s: simulated %d, expected %.1f ± %.1f", item.Name, got, item.Quantity, item.StdDev)
+		}
+	}
+}
+
+func TestCalculateTemporossDataWithSeed(t *testing.T) {
+	first, err := CalculateTemporossDataWithSeed(50, 99, FishTypeRaw, nil, nil, nil, 42)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	second, err := CalculateTemporossDataWithSeed(50, 99, FishTypeRaw, nil, nil, nil, 42)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if first.Seed != 42 {
+		t.Errorf("Expected seed 42 in result, got %d", first.Seed)
+	}
+	if first.TotalValue != second.TotalValue {
+		t.Errorf("Same seed should produce same total value: %d vs %d", first.TotalValue, second.TotalValue)
+	}
+}
+
+func TestCustomPointsPerGameBounds(t *testing.T) {
+	for _, points := range []int{-1, 0, MaxPointsPerGame + 1} {
+		if _, err := CalculateTemporossDataWithSeed(50, 60, FishTypeRaw, &points, nil, nil, 1); err == nil {
+			t.Errorf("Expected an error for %d points per game", points)
+		}
+		if err := (Calculator{}).Validate(TemporossInput{CurrentLevel: 50, TargetLevel: 60, FishType: "raw", CustomPointsPerGame: &points}); err == nil {
+			t.Errorf("Expected Validate to reject %d points per game", points)
+		}
+	}
+
+	points := MaxPointsPerGame
+	if _, err := CalculateTemporossDataWithSeed(35, 126, FishTypeRaw, &points, nil, nil, 1); err != nil {
+		t.Errorf("Unexpected error at the maximum points per game: %v", err)
+	}
+}
+
+func TestPetOnlyComesFromPetModel(t *testing.T) {
+	result, err := CalculateTemporossDataWithSeed(35, 99, FishTypeCooked, nil, nil, nil, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, exists := result.EstimatedLoot[TinyTempor.Name]; exists {
+		t.Errorf("%s should not be rolled as reward pool loot", TinyTempor.Name)
+	}
+	for _, item := range result.ExpectedLoot.Items {
+		if item.Name == TinyTempor.Name {
+			t.Errorf("%s should not be in the expected loot", TinyTempor.Name)
+		}
+	}
+}
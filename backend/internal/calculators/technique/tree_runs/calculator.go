@@ -0,0 +1,80 @@
+package treeruns
+
+import (
+	"fmt"
+
+	"osrs-xp-kits/internal/calculators"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+// TreeRunInput is the request body accepted by the tree run calculator
+type TreeRunInput struct {
+	CurrentLevel   int               `json:"current_level"`
+	TargetLevel    int               `json:"target_level"`
+	Patches        []string          `json:"patches"`
+	Trees          map[string]string `json:"trees"` // Patch category to tree, e.g. "fruit_tree": "palm"
+	CheckInsPerDay int               `json:"check_ins_per_day"`
+	PayProtection  bool              `json:"pay_protection"`
+}
+
+// Calculator exposes tree runs through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the tree run calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "tree_runs",
+		Name:        "Tree Runs",
+		Description: "Farming XP, sapling and protection costs, days to target and run order for tree, fruit tree and special tree patches",
+		Category:    "skilling",
+		Skills:      []string{"farming"},
+	}
+}
+
+// Validate checks the tree run input
+func (Calculator) Validate(input TreeRunInput) error {
+	if input.CurrentLevel < MinimumFarmingLevel {
+		return fmt.Errorf("farming level must be at least %d", MinimumFarmingLevel)
+	}
+	if input.TargetLevel < input.CurrentLevel {
+		return fmt.Errorf("target level must be greater than or equal to current level")
+	}
+	if input.TargetLevel > xp.MaxVirtualLevel {
+		return fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+	if input.CheckInsPerDay < 1 || input.CheckInsPerDay > MaxCheckInsPerDay {
+		return fmt.Errorf("check-ins per day must be between 1 and %d", MaxCheckInsPerDay)
+	}
+	if len(input.Patches) == 0 {
+		return fmt.Errorf("at least one patch is required")
+	}
+	for _, id := range input.Patches {
+		if _, exists := FindPatch(id); !exists {
+			return fmt.Errorf("invalid patch: %s", id)
+		}
+	}
+	for _, tree := range input.Trees {
+		if _, exists := Trees[tree]; !exists {
+			return fmt.Errorf("invalid tree: %s", tree)
+		}
+	}
+	return nil
+}
+
+// Calculate runs the tree run calculation
+func (Calculator) Calculate(input TreeRunInput, opts calculators.Options) (TreeRunResult, error) {
+	return CalculateTreeRunDataWithPrices(
+		input.CurrentLevel,
+		input.TargetLevel,
+		input.Patches,
+		input.Trees,
+		input.CheckInsPerDay,
+		input.PayProtection,
+		opts.Prices,
+	)
+}
+
+// ProTips returns the tree run calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
@@ -0,0 +1,116 @@
+package treeruns
+
+// Category is the kind of patch a tree grows in
+type Category string
+
+const (
+	CategoryTree       Category = "tree"
+	CategoryFruitTree  Category = "fruit_tree"
+	CategoryCalquat    Category = "calquat"
+	CategoryCelastrus  Category = "celastrus"
+	CategoryRedwood    Category = "redwood"
+	CategorySpiritTree Category = "spirit_tree"
+	CategoryHardwood   Category = "hardwood"
+)
+
+// Tree is a sapling that can be planted in a tree patch
+type Tree struct {
+	Name          string
+	Category      Category
+	Level         int
+	PlantXP       float64
+	CheckXP       float64 // XP for checking the health of the fully grown tree
+	GrowthMinutes int
+	Sapling       string // Sapling item name, used for live prices
+	SaplingValue  int    // Default price when live prices are unavailable
+	// Payment is the crop given to the gardener to protect the tree from disease
+	Payment         string
+	PaymentQuantity int
+	PaymentValue    int
+}
+
+// Trees keyed by the name used in requests
+var Trees = map[string]Tree{
+	// Normal trees
+	"oak":    {"Oak", CategoryTree, 15, 14, 467.3, 200, "Oak sapling", 100, "Tomatoes(5)", 1, 150},
+	"willow": {"Willow", CategoryTree, 30, 25, 1456.5, 280, "Willow sapling", 150, "Apples(5)", 1, 200},
+	"maple":  {"Maple", CategoryTree, 45, 45, 3403.4, 320, "Maple sapling", 1500, "Oranges(5)", 1, 300},
+	"yew":    {"Yew", CategoryTree, 60, 81, 7069.9, 400, "Yew sapling", 12000, "Cactus spine", 10, 2000},
+	"magic":  {"Magic", CategoryTree, 75, 145.5, 13768.3, 480, "Magic sapling", 80000, "Coconut", 25, 800},
+
+	// Fruit trees
+	"apple":       {"Apple", CategoryFruitTree, 27, 22, 1199.5, 960, "Apple sapling", 50, "Sweetcorn", 9, 60},
+	"banana":      {"Banana", CategoryFruitTree, 33, 28, 1750.5, 960, "Banana sapling", 100, "Apples(5)", 4, 200},
+	"orange":      {"Orange", CategoryFruitTree, 39, 35.5, 2470.2, 960, "Orange sapling", 200, "Strawberries(5)", 3, 500},
+	"curry":       {"Curry", CategoryFruitTree, 42, 40, 2906.9, 960, "Curry sapling", 300, "Bananas(5)", 5, 300},
+	"pineapple":   {"Pineapple", CategoryFruitTree, 51, 57, 4605.7, 960, "Pineapple sapling", 400, "Watermelon", 10, 100},
+	"papaya":      {"Papaya", CategoryFruitTree, 57, 72, 6146.4, 960, "Papaya sapling", 1500, "Pineapple", 10, 150},
+	"palm":        {"Palm", CategoryFruitTree, 68, 110.5, 10150.1, 960, "Palm sapling", 20000, "Papaya fruit", 15, 700},
+	"dragonfruit": {"Dragonfruit", CategoryFruitTree, 81, 140, 17335, 960, "Dragonfruit sapling", 80000, "Coconut", 15, 800},
+
+	// Special trees
+	"calquat":   {"Calquat", CategoryCalquat, 72, 129.5, 12096, 1280, "Calquat sapling", 2000, "Poison ivy berries", 8, 400},
+	"celastrus": {"Celastrus", CategoryCelastrus, 85, 204, 14130, 800, "Celastrus sapling", 40000, "Potato cactus", 8, 300},
+	"redwood":   {"Redwood", CategoryRedwood, 90, 230, 22450, 6400, "Redwood sapling", 30000, "Dragonfruit", 6, 2500},
+	"spirit":    {"Spirit", CategorySpiritTree, 83, 199.5, 19301.8, 3840, "Spirit sapling", 60000, "Monkey nuts", 5, 100},
+
+	// Hardwood trees
+	"teak":     {"Teak", CategoryHardwood, 35, 35, 7290, 4480, "Teak sapling", 300, "Limpwurt root", 15, 800},
+	"mahogany": {"Mahogany", CategoryHardwood, 55, 63, 15720, 5120, "Mahogany sapling", 2500, "Yanillian hops", 25, 50},
+}
+
+// Patch is a tree patch a player can own
+type Patch struct {
+	ID       string
+	Name     string
+	Category Category
+	Level    int // Farming level needed to use the patch, 0 when only the tree's level matters
+}
+
+// Patches in the order they are usually visited
+var Patches = []Patch{
+	{"lumbridge_tree", "Lumbridge", CategoryTree, 0},
+	{"varrock_tree", "Varrock", CategoryTree, 0},
+	{"falador_tree", "Falador", CategoryTree, 0},
+	{"taverley_tree", "Taverley", CategoryTree, 0},
+	{"gnome_stronghold_tree", "Tree Gnome Stronghold", CategoryTree, 0},
+	{"farming_guild_tree", "Farming Guild", CategoryTree, 65},
+
+	{"gnome_stronghold_fruit", "Tree Gnome Stronghold", CategoryFruitTree, 0},
+	{"tree_gnome_village_fruit", "Tree Gnome Village", CategoryFruitTree, 0},
+	{"brimhaven_fruit", "Brimhaven", CategoryFruitTree, 0},
+	{"catherby_fruit", "Catherby", CategoryFruitTree, 0},
+	{"lletya_fruit", "Lletya", CategoryFruitTree, 0},
+	{"farming_guild_fruit", "Farming Guild", CategoryFruitTree, 85},
+
+	{"tai_bwo_wannai_calquat", "Tai Bwo Wannai", CategoryCalquat, 0},
+	{"farming_guild_celastrus", "Farming Guild", CategoryCelastrus, 85},
+	{"farming_guild_redwood", "Farming Guild", CategoryRedwood, 90},
+
+	{"port_sarim_spirit", "Port Sarim", CategorySpiritTree, 0},
+	{"etceteria_spirit", "Etceteria", CategorySpiritTree, 0},
+	{"brimhaven_spirit", "Brimhaven", CategorySpiritTree, 0},
+	{"hosidius_spirit", "Hosidius", CategorySpiritTree, 0},
+	{"farming_guild_spirit", "Farming Guild", CategorySpiritTree, 91},
+
+	{"fossil_island_hardwood_1", "Fossil Island", CategoryHardwood, 0},
+	{"fossil_island_hardwood_2", "Fossil Island", CategoryHardwood, 0},
+	{"fossil_island_hardwood_3", "Fossil Island", CategoryHardwood, 0},
+}
+
+// FindPatch returns the patch with the given ID
+func FindPatch(id string) (Patch, bool) {
+	for _, patch := range Patches {
+		if patch.ID == id {
+			return patch, true
+		}
+	}
+	return Patch{}, false
+}
+
+// Tree run constants based on OSRS Wiki
+const (
+	MinimumFarmingLevel = 15
+
+	MaxCheckInsPerDay = 24
+)
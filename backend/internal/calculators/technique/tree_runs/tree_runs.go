@@ -0,0 +1,236 @@
+package treeruns
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"osrs-xp-kits/internal/calculators/progression"
+	"osrs-xp-kits/internal/calculators/tools"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+type TreeRunResult struct {
+	CurrentLevel         int       `json:"current_level"`
+	TargetLevel          int       `json:"target_level"`
+	XPNeeded             int       `json:"xp_needed"`
+	CheckInsPerDay       int       `json:"check_ins_per_day"`
+	PayProtection        bool      `json:"pay_protection"`
+	RunOrder             []RunStop `json:"run_order"`
+	FullRunXP            float64   `json:"full_run_xp"` // Every patch harvested and replanted in one run
+	XPPerRun             float64   `json:"xp_per_run"`  // Average over every check-in
+	XPPerDay             float64   `json:"xp_per_day"`
+	SaplingCostPerDay    int       `json:"sapling_cost_per_day"`
+	ProtectionCostPerDay int       `json:"protection_cost_per_day"`
+	CostPerDay           int       `json:"cost_per_day"`
+	DaysNeeded           float64   `json:"days_needed"`
+	TotalCost            int       `json:"total_cost"`
+
+	Progression progression.Progression `json:"progression"`
+}
+
+// RunStop is one patch in the run order and how often it is ready
+type RunStop struct {
+	PatchID         string  `json:"patch_id"`
+	Patch           string  `json:"patch"`
+	Category        string  `json:"category"`
+	Tree            string  `json:"tree"`
+	GrowthHours     float64 `json:"growth_hours"`
+	EveryNthRun     int     `json:"every_nth_run"` // Check-ins between harvests
+	CycleHours      float64 `json:"cycle_hours"`   // Time from planting to replanting
+	XPPerHarvest    float64 `json:"xp_per_harvest"`
+	SaplingPrice    int     `json:"sapling_price"`
+	ProtectionCost  int     `json:"protection_cost"`
+	PlantingsPerDay float64 `json:"plantings_per_day"`
+}
+
+func CalculateTreeRunData(currentLevel, targetLevel int, patchIDs []string, trees map[string]string, checkInsPerDay int, payProtection bool) (TreeRunResult, error) {
+	return CalculateTreeRunDataWithPrices(currentLevel, targetLevel, patchIDs, trees, checkInsPerDay, payProtection, nil)
+}
+
+// CalculateTreeRunDataWithPrices calculates tree run data with optional live sapling and payment prices.
+// trees maps each patch category to the tree planted in every patch of that category.
+func CalculateTreeRunDataWithPrices(currentLevel, targetLevel int, patchIDs []string, trees map[string]string, checkInsPerDay int, payProtection bool, livePrices map[string]int) (TreeRunResult, error) {
+	if currentLevel < MinimumFarmingLevel {
+		return TreeRunResult{}, fmt.Errorf("farming level must be at least %d", MinimumFarmingLevel)
+	}
+
+	if targetLevel < currentLevel {
+		return TreeRunResult{}, fmt.Errorf("target level must be greater than or equal to current level")
+	}
+
+	if targetLevel > xp.MaxVirtualLevel {
+		return TreeRunResult{}, fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+
+	if checkInsPerDay < 1 || checkInsPerDay > MaxCheckInsPerDay {
+		return TreeRunResult{}, fmt.Errorf("check-ins per day must be between 1 and %d", MaxCheckInsPerDay)
+	}
+
+	if len(patchIDs) == 0 {
+		return TreeRunResult{}, fmt.Errorf("at least one patch is required")
+	}
+
+	owned := make(map[string]bool, len(patchIDs))
+	for _, id := range patchIDs {
+		if _, exists := FindPatch(id); !exists {
+			return TreeRunResult{}, fmt.Errorf("invalid patch: %s", id)
+		}
+		owned[id] = true
+	}
+
+	// Check-ins are spread evenly over the day, so a tree is replanted at the first check-in after it is grown
+	intervalHours := 24 / float64(checkInsPerDay)
+
+	var stops []RunStop
+	fullRunXP, xpPerDay, saplingCost, protectionCost := 0.0, 0.0, 0.0, 0.0
+	for _, patch := range Patches {
+		if !owned[patch.ID] {
+			continue
+		}
+
+		treeName, chosen := trees[string(patch.Category)]
+		if !chosen {
+			return TreeRunResult{}, fmt.Errorf("no tree chosen for %s patches", patch.Category)
+		}
+		tree, exists := Trees[treeName]
+		if !exists {
+			return TreeRunResult{}, fmt.Errorf("invalid tree: %s", treeName)
+		}
+		if tree.Category != patch.Category {
+			return TreeRunResult{}, fmt.Errorf("%s cannot be planted in %s patches", tree.Name, patch.Category)
+		}
+		if currentLevel < tree.Level {
+			return TreeRunResult{}, fmt.Errorf("%s requires %d Farming", tree.Name, tree.Level)
+		}
+		if currentLevel < patch.Level {
+			return TreeRunResult{}, fmt.Errorf("the %s %s patch requires %d Farming", patch.Name, patch.Category, patch.Level)
+		}
+
+		growthHours := float64(tree.GrowthMinutes) / 60
+		everyNthRun := int(math.Ceil(growthHours/intervalHours - 1e-9))
+		cycleHours := float64(everyNthRun) * intervalHours
+		plantingsPerDay := 24 / cycleHours
+
+		sapling := tools.PriceOr(tree.Sapling, tree.SaplingValue, livePrices)
+		protection := 0
+		if payProtection {
+			protection = tools.PriceOr(tree.Payment, tree.PaymentValue, livePrices) * tree.PaymentQuantity
+		}
+
+		stop := RunStop{
+			PatchID:         patch.ID,
+			Patch:           patch.Name,
+			Category:        string(patch.Category),
+			Tree:            tree.Name,
+			GrowthHours:     growthHours,
+			EveryNthRun:     everyNthRun,
+			CycleHours:      cycleHours,
+			XPPerHarvest:    tree.PlantXP + tree.CheckXP,
+			SaplingPrice:    sapling,
+			ProtectionCost:  protection,
+			PlantingsPerDay: plantingsPerDay,
+		}
+		stops = append(stops, stop)
+
+		fullRunXP += stop.XPPerHarvest
+		xpPerDay += stop.XPPerHarvest * plantingsPerDay
+		saplingCost += float64(sapling) * plantingsPerDay
+		protectionCost += float64(protection) * plantingsPerDay
+	}
+
+	// Patches ready on every check-in come first, keeping the usual travel order within each group
+	slices.SortStableFunc(stops, func(a, b RunStop) int { return a.EveryNthRun - b.EveryNthRun })
+
+	// Walk level by level with one day as the action
+	currentXP := xp.ForLevel(currentLevel)
+	targetXP := xp.ForLevel(targetLevel)
+	levelProgression, err := progression.Walk(currentXP, targetXP, func(level int) progression.Rate {
+		return progression.Rate{
+			XPPerAction:    xpPerDay,
+			ActionsPerHour: 1.0 / 24,
+			SuccessChance:  1,
+		}
+	})
+	if err != nil {
+		return TreeRunResult{}, err
+	}
+
+	daysNeeded := levelProgression.TotalActions
+	costPerDay := saplingCost + protectionCost
+
+	return TreeRunResult{
+		CurrentLevel:         currentLevel,
+		TargetLevel:          targetLevel,
+		XPNeeded:             targetXP - currentXP,
+		CheckInsPerDay:       checkInsPerDay,
+		PayProtection:        payProtection,
+		RunOrder:             stops,
+		FullRunXP:            fullRunXP,
+		XPPerRun:             xpPerDay / float64(checkInsPerDay),
+		XPPerDay:             xpPerDay,
+		SaplingCostPerDay:    int(math.Round(saplingCost)),
+		ProtectionCostPerDay: int(math.Round(protectionCost)),
+		CostPerDay:           int(math.Round(costPerDay)),
+		DaysNeeded:           daysNeeded,
+		TotalCost:            int(math.Round(costPerDay * math.Ceil(daysNeeded))),
+		Progression:          levelProgression,
+	}, nil
+}
+
+// GetCalculationProTips provides detailed information about how tree run calculations work
+func GetCalculationProTips() map[string]any {
+	return map[string]any{
+		"calculation_methodology": map[string]any{
+			"xp_rates_source": "Planting and health check XP from the OSRS Wiki",
+			"base_formula":    "XP per day = Σ (planting + health check XP) × plantings per day for every owned patch",
+			"data_points": []map[string]any{
+				{"level": 68, "trees": "5 yew, 5 palm", "check_ins_per_day": 3, "xp_per_day": 184000},
+				{"level": 75, "trees": "5 magic, 5 palm", "check_ins_per_day": 3, "xp_per_day": 286000},
+				{"level": 85, "trees": "6 magic, 6 dragonfruit, celastrus", "check_ins_per_day": 3, "xp_per_day": 429000},
+			},
+		},
+		"game_mechanics": map[string]any{
+			"growth_timers": "Trees take 3-8 hours, fruit trees 16 hours, calquat 21 hours, spirit trees 64 hours and redwoods over 4 days",
+			"protection":    "Paying the gardener protects a tree from disease so it always reaches the health check",
+			"xp_sources": []string{
+				"Planting the sapling",
+				"Checking the health of the fully grown tree (most of the XP)",
+			},
+		},
+		"factors_considered": []string{
+			"Farming level (affects the trees and Farming Guild patches available)",
+			"Owned patches and the tree planted in each patch category",
+			"Check-ins per day (a tree is replanted at the first check-in after it has grown)",
+			"Sapling and protection payment prices",
+		},
+		"accuracy_notes": map[string]any{
+			"rates_vary": "Daily XP depends on keeping to the schedule:",
+			"variance_factors": []string{
+				"Missed check-ins delay every tree that was ready",
+				"Unprotected trees can die from disease",
+				"The first run only plants, so health check XP starts one cycle later",
+			},
+			"calculation_basis": "Check-ins are assumed to be evenly spread over the day with every tree protected",
+		},
+		"pro_tips": []map[string]string{
+			{
+				"tip":         "Match Check-ins to Timers",
+				"description": "Three check-ins eight hours apart catch magic trees and fruit trees with little time wasted",
+			},
+			{
+				"tip":         "Stack Payments",
+				"description": "Grow the protection payments in allotments so tree runs cost only the saplings",
+			},
+			{
+				"tip":         "Fruit Trees for XP",
+				"description": "Fruit trees give most of the health check XP of normal trees at a fraction of the sapling cost",
+			},
+		},
+		"reward_calculation": map[string]any{
+			"cost_per_day": "Sapling and protection payment prices × plantings per day",
+			"days_needed":  "XP needed ÷ XP per day, walked level by level",
+			"run_order":    "Patches ready on every check-in first, then patches that need several check-ins to grow",
+		},
+	}
+}
@@ -0,0 +1,147 @@
+package treeruns
+
+import (
+	"math"
+	"testing"
+)
+
+var testPatches = []string{
+	"lumbridge_tree", "varrock_tree", "falador_tree", "taverley_tree", "gnome_stronghold_tree",
+	"gnome_stronghold_fruit", "tree_gnome_village_fruit", "brimhaven_fruit", "catherby_fruit", "lletya_fruit",
+}
+
+func TestCalculateTreeRunData(t *testing.T) {
+	tests := []struct {
+		name         string
+		currentLevel int
+		targetLevel  int
+		patches      []string
+		trees        map[string]string
+		expectError  bool
+	}{
+		{
+			name:         "Level 45 maples and curries",
+			currentLevel: 45,
+			targetLevel:  60,
+			patches:      testPatches,
+			trees:        map[string]string{"tree": "maple", "fruit_tree": "curry"},
+		},
+		{
+			name:         "Level 90 with special trees",
+			currentLevel: 90,
+			targetLevel:  99,
+			patches:      append([]string{"farming_guild_redwood", "port_sarim_spirit", "fossil_island_hardwood_1"}, testPatches...),
+			trees:        map[string]string{"tree": "magic", "fruit_tree": "dragonfruit", "redwood": "redwood", "spirit_tree": "spirit", "hardwood": "mahogany"},
+		},
+		{
+			name:         "Tree level too high",
+			currentLevel: 60,
+			targetLevel:  70,
+			patches:      testPatches,
+			trees:        map[string]string{"tree": "magic", "fruit_tree": "papaya"},
+			expectError:  true,
+		},
+		{
+			name:         "No tree for an owned patch",
+			currentLevel: 60,
+			targetLevel:  70,
+			patches:      testPatches,
+			trees:        map[string]string{"tree": "yew"},
+			expectError:  true,
+		},
+		{
+			name:         "Tree in the wrong patch",
+			currentLevel: 60,
+			targetLevel:  70,
+			patches:      []string{"lumbridge_tree"},
+			trees:        map[string]string{"tree": "papaya"},
+			expectError:  true,
+		},
+		{
+			name:         "Farming Guild patch level",
+			currentLevel: 60,
+			targetLevel:  70,
+			patches:      []string{"farming_guild_tree"},
+			trees:        map[string]string{"tree": "yew"},
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculateTreeRunData(tt.currentLevel, tt.targetLevel, tt.patches, tt.trees, 3, true)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(result.RunOrder) != len(tt.patches) {
+				t.Errorf("Expected %d stops, got %d", len(tt.patches), len(result.RunOrder))
+			}
+			if result.XPPerDay <= 0 || result.DaysNeeded <= 0 {
+				t.Errorf("XP per day and days needed should be positive: %.0f, %.1f", result.XPPerDay, result.DaysNeeded)
+			}
+			if result.CostPerDay != result.SaplingCostPerDay+result.ProtectionCostPerDay {
+				t.Errorf("Cost per day should be saplings plus protection")
+			}
+			for i := 1; i < len(result.RunOrder); i++ {
+				if result.RunOrder[i].EveryNthRun < result.RunOrder[i-1].EveryNthRun {
+					t.Errorf("Run order should put patches that are ready more often first")
+				}
+			}
+		})
+	}
+}
+
+func TestGrowthTimerFitsCheckIns(t *testing.T) {
+	result, err := CalculateTreeRunData(75, 80, testPatches, map[string]string{"tree": "magic", "fruit_tree": "palm"}, 3, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, stop := range result.RunOrder {
+		switch stop.Tree {
+		case "Magic":
+			// 8 hours of growth fits exactly between check-ins 8 hours apart
+			if stop.EveryNthRun != 1 || stop.PlantingsPerDay != 3 {
+				t.Errorf("Magic trees should be replanted every run, got every %d", stop.EveryNthRun)
+			}
+		case "Palm":
+			if stop.EveryNthRun != 2 || stop.PlantingsPerDay != 1.5 {
+				t.Errorf("Palm trees should be replanted every second run, got every %d", stop.EveryNthRun)
+			}
+		}
+	}
+
+	if result.ProtectionCostPerDay != 0 {
+		t.Errorf("Protection should cost nothing when not paid, got %d", result.ProtectionCostPerDay)
+	}
+
+	wantXPPerDay := 5*3*(145.5+13768.3) + 5*1.5*(110.5+10150.1)
+	if math.Abs(result.XPPerDay-wantXPPerDay) > 1e-6 {
+		t.Errorf("XP per day = %.1f, want %.1f", result.XPPerDay, wantXPPerDay)
+	}
+}
+
+func TestMoreCheckInsNeverSlower(t *testing.T) {
+	trees := map[string]string{"tree": "yew", "fruit_tree": "palm"}
+	once, err := CalculateTreeRunData(70, 80, testPatches, trees, 1, true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	often, err := CalculateTreeRunData(70, 80, testPatches, trees, 4, true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if often.DaysNeeded >= once.DaysNeeded {
+		t.Errorf("More check-ins should need fewer days: %.1f vs %.1f", often.DaysNeeded, once.DaysNeeded)
+	}
+}
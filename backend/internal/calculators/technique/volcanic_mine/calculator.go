@@ -0,0 +1,43 @@
+package volcanicmine
+
+import (
+	"osrs-xp-kits/internal/calculators"
+)
+
+// VolcanicMineInput is the request body accepted by the Volcanic Mine calculator
+type VolcanicMineInput struct {
+	CurrentLevel         int      `json:"current_level"`
+	TargetLevel          int      `json:"target_level"`
+	TeamSize             string   `json:"team_size"`
+	CustomPointsPerGame  *int     `json:"custom_points_per_game,omitempty"`
+	CustomMinutesPerGame *float64 `json:"custom_minutes_per_game,omitempty"`
+}
+
+// Calculator exposes Volcanic Mine through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the Volcanic Mine calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "volcanic_mine",
+		Name:        "Volcanic Mine",
+		Description: "Mining XP from Volcanic Mine points and the value of numulite and volcanic ash from the reward shop",
+		Category:    "minigame",
+		Skills:      []string{"mining"},
+	}
+}
+
+// Validate checks the Volcanic Mine input
+func (Calculator) Validate(input VolcanicMineInput) error {
+	return validateInput(input)
+}
+
+// Calculate runs the Volcanic Mine calculation
+func (Calculator) Calculate(input VolcanicMineInput, opts calculators.Options) (VolcanicMineResult, error) {
+	return CalculateVolcanicMineDataWithPrices(input, opts.Prices)
+}
+
+// ProTips returns the Volcanic Mine calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
@@ -0,0 +1,68 @@
+package volcanicmine
+
+// RewardItem is an item bought from the Volcanic Mine reward shop
+type RewardItem struct {
+	Name       string `json:"name"`
+	PointsCost int    `json:"points_cost"` // Reward points per item
+	Value      int    `json:"value"`
+}
+
+// RewardShop holds the items worth converting reward points into
+var RewardShop = []RewardItem{
+	{"Numulite", 30, 30},
+	{"Volcanic ash", 60, 90},
+}
+
+// Team size presets
+type TeamSize string
+
+const (
+	TeamSolo TeamSize = "solo"
+	TeamDuo  TeamSize = "duo"
+	TeamFour TeamSize = "team_4"
+	TeamMass TeamSize = "mass"
+)
+
+// Team data for points and time per game
+var TeamData = map[TeamSize]struct {
+	PointsPerGame  int
+	MinutesPerGame float64 // Includes the lobby between games
+	Description    string
+	Requirements   []string
+}{
+	TeamSolo: {
+		PointsPerGame:  15000,
+		MinutesPerGame: 12.0,
+		Description:    "Solo games, mining and stabilising the vents alone",
+		Requirements:   []string{"Level 50 Mining", "Level 100 Kudos", "Pickaxe"},
+	},
+	TeamDuo: {
+		PointsPerGame:  35000,
+		MinutesPerGame: 11.5,
+		Description:    "Two players, one mining and one managing the vents",
+		Requirements:   []string{"Level 50 Mining", "Level 100 Kudos", "Pickaxe"},
+	},
+	TeamFour: {
+		PointsPerGame:  60000,
+		MinutesPerGame: 11.0,
+		Description:    "Standard team of four with a dedicated vent caller",
+		Requirements:   []string{"Level 50 Mining", "Level 100 Kudos", "Pickaxe", "Prospector kit recommended"},
+	},
+	TeamMass: {
+		PointsPerGame:  45000,
+		MinutesPerGame: 11.0,
+		Description:    "Large public world teams with shared ore and less coordination",
+		Requirements:   []string{"Level 50 Mining", "Level 100 Kudos", "Pickaxe"},
+	},
+}
+
+// Volcanic Mine constants based on OSRS Wiki
+const (
+	MinimumMiningLevel = 50
+
+	// XPPerPointPerLevel converts game points to Mining XP at the end of a game
+	XPPerPointPerLevel = 0.002
+
+	// RewardPointsPerGamePoint converts game points to reward shop points
+	RewardPointsPerGamePoint = 0.1
+)
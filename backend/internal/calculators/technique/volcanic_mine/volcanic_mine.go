@@ -0,0 +1,208 @@
+package volcanicmine
+
+import (
+	"fmt"
+	"math"
+
+	"osrs-xp-kits/internal/calculators/progression"
+	"osrs-xp-kits/internal/calculators/tools"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+type VolcanicMineResult struct {
+	CurrentLevel int    `json:"current_level"`
+	TargetLevel  int    `json:"target_level"`
+	XPNeeded     int    `json:"xp_needed"`
+	TeamSize     string `json:"team_size"`
+
+	PointsPerGame  int     `json:"points_per_game"`
+	MinutesPerGame float64 `json:"minutes_per_game"`
+	GamesPerHour   float64 `json:"games_per_hour"`
+
+	AverageXPPerGame float64 `json:"average_xp_per_game"`
+	AverageXPPerHour float64 `json:"average_xp_per_hour"`
+	GamesNeeded      int     `json:"games_needed"`
+	HoursNeeded      float64 `json:"hours_needed"`
+
+	RewardPointsPerGame float64            `json:"reward_points_per_game"`
+	TotalRewardPoints   int                `json:"total_reward_points"`
+	Rewards             []RewardConversion `json:"rewards"`
+	BestReward          string             `json:"best_reward"`
+	GPPerHour           int                `json:"gp_per_hour"` // Converting into the best reward
+	TotalValue          int                `json:"total_value"`
+
+	Progression progression.Progression `json:"progression"`
+}
+
+// RewardConversion is what the reward points buy of one shop item
+type RewardConversion struct {
+	Name         string  `json:"name"`
+	PointsCost   int     `json:"points_cost"`
+	Price        int     `json:"price"`
+	GPPerPoint   float64 `json:"gp_per_point"`
+	ItemsPerHour float64 `json:"items_per_hour"`
+	TotalItems   int     `json:"total_items"`
+	GPPerHour    int     `json:"gp_per_hour"`
+	TotalValue   int     `json:"total_value"`
+}
+
+func CalculateVolcanicMineData(input VolcanicMineInput) (VolcanicMineResult, error) {
+	return CalculateVolcanicMineDataWithPrices(input, nil)
+}
+
+// CalculateVolcanicMineDataWithPrices calculates Volcanic Mine data with optional live reward prices
+func CalculateVolcanicMineDataWithPrices(input VolcanicMineInput, livePrices map[string]int) (VolcanicMineResult, error) {
+	if err := validateInput(input); err != nil {
+		return VolcanicMineResult{}, err
+	}
+
+	// Get team data or use custom values
+	teamInfo := TeamData[TeamSize(input.TeamSize)]
+	pointsPerGame := teamInfo.PointsPerGame
+	minutesPerGame := teamInfo.MinutesPerGame
+	if input.CustomPointsPerGame != nil {
+		pointsPerGame = *input.CustomPointsPerGame
+	}
+	if input.CustomMinutesPerGame != nil {
+		minutesPerGame = *input.CustomMinutesPerGame
+	}
+	gamesPerHour := 60.0 / minutesPerGame
+
+	// Points are converted to XP at the end of the game using the Mining level
+	currentXP := xp.ForLevel(input.CurrentLevel)
+	targetXP := xp.ForLevel(input.TargetLevel)
+	levelProgression, err := progression.Walk(currentXP, targetXP, func(level int) progression.Rate {
+		return progression.Rate{
+			XPPerAction:    float64(pointsPerGame) * float64(level) * XPPerPointPerLevel,
+			ActionsPerHour: gamesPerHour,
+			SuccessChance:  1,
+		}
+	})
+	if err != nil {
+		return VolcanicMineResult{}, err
+	}
+	games := int(math.Ceil(levelProgression.TotalActions))
+
+	rewardPointsPerGame := float64(pointsPerGame) * RewardPointsPerGamePoint
+	totalRewardPoints := int(rewardPointsPerGame * float64(games))
+	rewards := make([]RewardConversion, 0, len(RewardShop))
+	best := RewardConversion{}
+	for _, item := range RewardShop {
+		price := tools.PriceOr(item.Name, item.Value, livePrices)
+		itemsPerHour := rewardPointsPerGame * gamesPerHour / float64(item.PointsCost)
+		totalItems := totalRewardPoints / item.PointsCost
+		conversion := RewardConversion{
+			Name:         item.Name,
+			PointsCost:   item.PointsCost,
+			Price:        price,
+			GPPerPoint:   float64(price) / float64(item.PointsCost),
+			ItemsPerHour: itemsPerHour,
+			TotalItems:   totalItems,
+			GPPerHour:    int(math.Round(itemsPerHour * float64(price))),
+			TotalValue:   totalItems * price,
+		}
+		rewards = append(rewards, conversion)
+		if conversion.GPPerPoint > best.GPPerPoint {
+			best = conversion
+		}
+	}
+
+	return VolcanicMineResult{
+		CurrentLevel:        input.CurrentLevel,
+		TargetLevel:         input.TargetLevel,
+		XPNeeded:            targetXP - currentXP,
+		TeamSize:            input.TeamSize,
+		PointsPerGame:       pointsPerGame,
+		MinutesPerGame:      minutesPerGame,
+		GamesPerHour:        gamesPerHour,
+		AverageXPPerGame:    levelProgression.AverageXPPerAction(),
+		AverageXPPerHour:    levelProgression.AverageXPPerHour,
+		GamesNeeded:         games,
+		HoursNeeded:         levelProgression.TotalHours,
+		RewardPointsPerGame: rewardPointsPerGame,
+		TotalRewardPoints:   totalRewardPoints,
+		Rewards:             rewards,
+		BestReward:          best.Name,
+		GPPerHour:           best.GPPerHour,
+		TotalValue:          best.TotalValue,
+		Progression:         levelProgression,
+	}, nil
+}
+
+// validateInput checks the levels, team size and custom values
+func validateInput(input VolcanicMineInput) error {
+	if input.CurrentLevel < MinimumMiningLevel || input.CurrentLevel > xp.MaxLevel {
+		return fmt.Errorf("mining level must be between %d and %d", MinimumMiningLevel, xp.MaxLevel)
+	}
+	if input.TargetLevel < input.CurrentLevel {
+		return fmt.Errorf("target level must be greater than or equal to current level")
+	}
+	if input.TargetLevel > xp.MaxVirtualLevel {
+		return fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+	if _, exists := TeamData[TeamSize(input.TeamSize)]; !exists {
+		return fmt.Errorf("invalid team size: %s", input.TeamSize)
+	}
+	if input.CustomPointsPerGame != nil && *input.CustomPointsPerGame <= 0 {
+		return fmt.Errorf("points per game must be greater than 0")
+	}
+	if input.CustomMinutesPerGame != nil && *input.CustomMinutesPerGame <= 0 {
+		return fmt.Errorf("minutes per game must be greater than 0")
+	}
+	return nil
+}
+
+func GetCalculationProTips() map[string]any {
+	return map[string]any{
+		"calculation_methodology": map[string]any{
+			"xp_rates_source": "Point to XP conversion from the OSRS Wiki, points per game from community team testing",
+			"base_formula":    "XP per game = points × Mining level × 0.002",
+			"data_points": []map[string]any{
+				{"team_size": "solo", "level": 98, "xp_per_hour": 14700},
+				{"team_size": "duo", "level": 98, "xp_per_hour": 35800},
+				{"team_size": "team_4", "level": 98, "xp_per_hour": 64100},
+			},
+		},
+		"game_mechanics": map[string]any{
+			"points":  "Mining lava and boulder fragments earns points, and a collapsed vent or cave-in loses them",
+			"xp":      "Points are converted to Mining XP at the end of the game using the current Mining level",
+			"vents":   "Keeping the vents stable stops the chamber from collapsing before the game ends",
+			"rewards": "A tenth of the game points are given as reward points for the Volcanic Mine shop",
+		},
+		"factors_considered": []string{
+			"Mining level at the end of each game",
+			"Team size and points per game",
+			"Game length including the lobby",
+			"Numulite and volcanic ash prices",
+		},
+		"accuracy_notes": map[string]any{
+			"rates_vary": "Points per game depend on the team:",
+			"variance_factors": []string{
+				"How well the vents are kept stable",
+				"Whether the boulder is fully mined",
+				"Deaths and lost points in public worlds",
+			},
+			"calculation_basis": "Every game scores the team preset's points",
+		},
+		"pro_tips": []map[string]string{
+			{
+				"tip":         "Join a Set Team",
+				"description": "Coordinated teams of four score far more points than public worlds",
+			},
+			{
+				"tip":         "Wear the Prospector Kit",
+				"description": "The prospector kit adds 2.5% Mining XP and is bought from Motherlode Mine",
+			},
+			{
+				"tip":         "Convert to the Best Reward",
+				"description": "Compare the GP per point of numulite and volcanic ash before spending reward points",
+			},
+		},
+		"reward_calculation": map[string]any{
+			"reward_points": "Reward points are a tenth of the game points",
+			"numulite":      "30 reward points each",
+			"volcanic_ash":  "60 reward points each",
+			"best_reward":   "GP per hour uses whichever reward gives the most GP per point at live prices",
+		},
+	}
+}
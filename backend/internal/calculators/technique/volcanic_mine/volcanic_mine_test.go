@@ -0,0 +1,102 @@
+package volcanicmine
+
+import (
+	"testing"
+)
+
+func TestCalculateVolcanicMineData(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       VolcanicMineInput
+		expectError bool
+	}{
+		{
+			name:  "Solo games",
+			input: VolcanicMineInput{CurrentLevel: 50, TargetLevel: 70, TeamSize: "solo"},
+		},
+		{
+			name:  "Team of four",
+			input: VolcanicMineInput{CurrentLevel: 80, TargetLevel: 90, TeamSize: "team_4"},
+		},
+		{
+			name:  "Mass world",
+			input: VolcanicMineInput{CurrentLevel: 90, TargetLevel: 99, TeamSize: "mass"},
+		},
+		{
+			name:        "Mining level too low",
+			input:       VolcanicMineInput{CurrentLevel: 40, TargetLevel: 60, TeamSize: "duo"},
+			expectError: true,
+		},
+		{
+			name:        "Invalid team size",
+			input:       VolcanicMineInput{CurrentLevel: 70, TargetLevel: 80, TeamSize: "raid"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculateVolcanicMineData(tt.input)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result.GamesNeeded <= 0 || result.HoursNeeded <= 0 {
+				t.Errorf("Expected games and hours, got %d and %.1f", result.GamesNeeded, result.HoursNeeded)
+			}
+			if len(result.Rewards) != len(RewardShop) {
+				t.Errorf("Expected %d reward conversions, got %d", len(RewardShop), len(result.Rewards))
+			}
+			if result.GPPerHour <= 0 {
+				t.Errorf("Expected positive GP per hour, got %d", result.GPPerHour)
+			}
+		})
+	}
+}
+
+func TestXPScalesWithLevel(t *testing.T) {
+	low, err := CalculateVolcanicMineData(VolcanicMineInput{CurrentLevel: 60, TargetLevel: 61, TeamSize: "team_4"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	high, err := CalculateVolcanicMineData(VolcanicMineInput{CurrentLevel: 90, TargetLevel: 91, TeamSize: "team_4"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := float64(TeamData[TeamFour].PointsPerGame) * 90 * XPPerPointPerLevel
+	if high.AverageXPPerGame != expected {
+		t.Errorf("Expected %.0f XP per game at level 90, got %.0f", expected, high.AverageXPPerGame)
+	}
+	if high.AverageXPPerHour <= low.AverageXPPerHour {
+		t.Errorf("Higher levels should give more XP per hour: %.0f vs %.0f", high.AverageXPPerHour, low.AverageXPPerHour)
+	}
+}
+
+func TestLivePricesPickBestReward(t *testing.T) {
+	input := VolcanicMineInput{CurrentLevel: 80, TargetLevel: 85, TeamSize: "duo"}
+
+	result, err := CalculateVolcanicMineDataWithPrices(input, map[string]int{"Numulite": 100})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.BestReward != "Numulite" {
+		t.Errorf("Expected numulite at 100 gp to be the best reward, got %s", result.BestReward)
+	}
+
+	result, err = CalculateVolcanicMineDataWithPrices(input, map[string]int{"Numulite": 10})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.BestReward != "Volcanic ash" {
+		t.Errorf("Expected volcanic ash to be the best reward, got %s", result.BestReward)
+	}
+}
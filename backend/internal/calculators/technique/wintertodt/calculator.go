@@ -0,0 +1,82 @@
+package wintertodt
+
+import (
+	"fmt"
+
+	"osrs-xp-kits/internal/calculators"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+// WintertodtInput is the request body accepted by the Wintertodt calculator
+type WintertodtInput struct {
+	CurrentLevel          int         `json:"current_level"`
+	TargetLevel           int         `json:"target_level"`
+	Strategy              string      `json:"strategy"`
+	CustomPointsPerRound  *int        `json:"custom_points_per_round,omitempty"`
+	CustomMinutesPerRound *float64    `json:"custom_minutes_per_round,omitempty"`
+	SkillLevels           SkillLevels `json:"skill_levels"`
+}
+
+// Calculator exposes Wintertodt through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the Wintertodt calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "wintertodt",
+		Name:        "Wintertodt",
+		Description: "Firemaking XP, supply crate loot and Phoenix pet odds at the Wintertodt",
+		Category:    "minigame",
+		Skills:      []string{"firemaking"},
+	}
+}
+
+// Validate checks the Wintertodt input
+func (Calculator) Validate(input WintertodtInput) error {
+	if input.CurrentLevel < 50 {
+		return fmt.Errorf("firemaking level must be at least 50")
+	}
+	if input.TargetLevel < input.CurrentLevel {
+		return fmt.Errorf("target level must be greater than or equal to current level")
+	}
+	if input.TargetLevel > xp.MaxVirtualLevel {
+		return fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+	if _, exists := StrategyData[Strategy(input.Strategy)]; !exists {
+		return fmt.Errorf("invalid strategy: %s", input.Strategy)
+	}
+	if input.CustomPointsPerRound != nil {
+		return validatePointsPerRound(*input.CustomPointsPerRound)
+	}
+	return nil
+}
+
+// Calculate runs the Wintertodt calculation
+func (Calculator) Calculate(input WintertodtInput, opts calculators.Options) (WintertodtResult, error) {
+	result, err := CalculateWintertodtDataWithSeed(
+		input.CurrentLevel,
+		input.TargetLevel,
+		Strategy(input.Strategy),
+		input.CustomPointsPerRound,
+		input.CustomMinutesPerRound,
+		input.SkillLevels,
+		opts.Prices,
+		opts.Seed,
+	)
+	if err != nil || opts.Simulations == 0 {
+		return result, err
+	}
+
+	distribution, err := SimulateLootDistribution(opts.RequestContext(), result.RoundsNeeded, result.PointsPerRound, input.SkillLevels, opts.Prices, opts.Simulations, opts.Seed)
+	if err != nil {
+		return WintertodtResult{}, err
+	}
+	result.Distribution = &distribution
+
+	return result, nil
+}
+
+// ProTips returns the Wintertodt calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
@@ -1,5 +1,10 @@
 package wintertodt
 
+import "osrs-xp-kits/internal/calculators/pets"
+
+// Phoenix is rolled once per supply crate, with better odds at higher Firemaking levels
+var Phoenix = pets.Pet{Name: "Phoenix", BaseRate: 5000}
+
 type LootItem struct {
 	Name     string  `json:"name"`
 	Quantity int     `json:"quantity"`
@@ -7,9 +12,9 @@ type LootItem struct {
 	Rate     float64 `json:"rate"`
 }
 
-// Unique items rolled in order (1 roll per round regardless of points)
+// Unique items rolled in order (1 roll per round regardless of points).
+// The Phoenix is not listed: its chance scales with Firemaking level, see Phoenix and PetOdds.
 var UniqueRolls = []LootItem{
-	{"Phoenix", 1, 0, 1.0 / 5000.0},           // 1/5,000
 	{"Dragon axe", 1, 8500000, 1.0 / 10000.0}, // 1/10,000
 	{"Tome of fire", 1, 500000, 1.0 / 1000.0}, // 1/1,000
 	{"Warm gloves", 1, 150000, 1.0 / 150.0},   // 1/150
@@ -30,10 +35,11 @@ var SupplyDrops = []LootItem{
 	{"Magic logs", 5, 1000, 0.05},
 }
 
+// MaxPointsPerRound bounds custom points per round: reward rolls stop growing at 28 rolls, reached at 13,500 points
+const MaxPointsPerRound = 13500
+
 // Experience calculation constants based on OSRS Wiki
 const (
-	PetRatePerCrate = 0.0002 // 1/5000
-
 	// Firemaking XP multipliers
 	LightingBrazierXP    = 6.0   // 6x Firemaking level
 	FeedingRootXP        = 3.0   // 3x Firemaking level
@@ -0,0 +1,65 @@
+package wintertodt
+
+import (
+	"osrs-xp-kits/internal/calculators/tools"
+)
+
+// CalculateExpectedLoot returns the exact expected loot for the given rounds, without any RNG.
+// The conversion of three warm gloves or bruma torches into seeds is not included.
+func CalculateExpectedLoot(rounds int, pointsPerRound int, skillLevels SkillLevels, livePrices map[string]int) tools.ExpectedLoot {
+	crates := tools.DropTable{{Name: "Supply crate", Probability: 1}}.Expected(rounds)
+	uniques := uniqueDropTable(livePrices).ExpectedIndependent(rounds)
+
+	meanRolls, rollVariance := ExpectedRolls(pointsPerRound)
+	supplies := supplyDropTable(skillLevels, livePrices).ExpectedCompound(
+		float64(rounds)*meanRolls,
+		float64(rounds)*rollVariance,
+	)
+
+	return tools.MergeExpected(crates, uniques, supplies)
+}
+
+// uniqueDropTable converts UniqueRolls into a drop table. Every unique is rolled independently.
+func uniqueDropTable(livePrices map[string]int) tools.DropTable {
+	table := make(tools.DropTable, 0, len(UniqueRolls))
+	for _, unique := range UniqueRolls {
+		price := unique.Value
+		if livePrice, exists := livePrices[unique.Name]; exists {
+			price = livePrice
+		}
+		// The simulators never count the outfit towards the total value
+		if unique.Name == "Pyromancer outfit" {
+			price = 0
+		}
+
+		table = append(table, tools.DropItem{
+			Name:        unique.Name,
+			Probability: unique.Rate,
+			Price:       price,
+			MinQuantity: unique.Quantity,
+			MaxQuantity: unique.Quantity,
+		})
+	}
+	return table
+}
+
+// supplyDropTable converts the skill-enhanced supply drops into an exclusive drop table.
+// Supply drops are checked in order and the first hit wins, so each item's chance per roll
+// is its rate times the chance that every earlier item missed.
+func supplyDropTable(skillLevels SkillLevels, livePrices map[string]int) tools.DropTable {
+	drops := getEnhancedSupplyDropsWithLivePrices(skillLevels, livePrices)
+
+	table := make(tools.DropTable, 0, len(drops))
+	missedSoFar := 1.0
+	for _, item := range drops {
+		table = append(table, tools.DropItem{
+			Name:        item.Name,
+			Probability: item.Rate * missedSoFar,
+			Price:       item.Value,
+			MinQuantity: item.Quantity,
+			MaxQuantity: item.Quantity,
+		})
+		missedSoFar *= 1 - item.Rate
+	}
+	return table
+}
@@ -26,7 +26,7 @@ func SimulateLootWithSeed(rounds int, seed int64) (map[string]any, int) {
 		for _, item := range UniqueRolls {
 			if r.Float64() < item.Rate {
 				lootCounts[item.Name] += item.Quantity
-				if item.Name != "Phoenix" && item.Name != "Pyromancer outfit" {
+				if item.Name != "Pyromancer outfit" {
 					totalValue += item.Quantity * item.Value
 				}
 			}
@@ -74,8 +74,9 @@ func SimulateLootWithLivePrices(rounds int, pointsPerRound int, skillLevels Skil
 	return SimulateLootWithLivePricesAndSeed(rounds, pointsPerRound, skillLevels, livePrices, time.Now().UnixNano())
 }
 
-// CalculateRolls calculates the number of reward rolls based on points
-func CalculateRolls(points int) int {
+// CalculateRolls calculates the number of reward rolls based on points.
+// The fractional extra roll is decided with r so seeded simulations stay reproducible.
+func CalculateRolls(points int, r *rand.Rand) int {
 	if points < 500 {
 		return 0 // No rewards if less than 500 points
 	}
@@ -93,13 +94,25 @@ func CalculateRolls(points int) int {
 
 	// Add probabilistic extra roll based on remaining chance
 	fractionalChance := expectedExtraRolls - math.Floor(expectedExtraRolls)
-	if rand.Float64() < fractionalChance {
+	if r.Float64() < fractionalChance {
 		totalRolls++
 	}
 
 	return totalRolls
 }
 
+// ExpectedRolls returns the mean and variance of the reward rolls per round that CalculateRolls gives
+func ExpectedRolls(points int) (mean, variance float64) {
+	if points < 500 {
+		return 0, 0
+	}
+
+	expectedExtraRolls := float64(points-500) / 5.0 / 100.0
+	fractionalChance := expectedExtraRolls - math.Floor(expectedExtraRolls)
+
+	return 2 + expectedExtraRolls, fractionalChance * (1 - fractionalChance)
+}
+
 // SimulateLootWithSkillsAndSeed simulates Wintertodt loot with proper reward cart mechanics
 func SimulateLootWithSkillsAndSeed(rounds int, skillLevels SkillLevels, seed int64) (map[string]any, int) {
 	r := rand.New(rand.NewSource(seed))
@@ -119,7 +132,7 @@ func SimulateLootWithSkillsAndSeed(rounds int, skillLevels SkillLevels, seed int
 		for _, unique := range UniqueRolls {
 			if r.Float64() < unique.Rate {
 				lootCounts[unique.Name] += unique.Quantity
-				if unique.Name != "Phoenix" && unique.Name != "Pyromancer outfit" {
+				if unique.Name != "Pyromancer outfit" {
 					totalValue += unique.Quantity * unique.Value
 				}
 
@@ -155,7 +168,7 @@ func SimulateLootWithSkillsAndSeed(rounds int, skillLevels SkillLevels, seed int
 	// Simulate reward rolls for each round (based on points)
 	for range rounds {
 		// Use provided points per round for accurate roll calculation
-		rolls := CalculateRolls(600) // Default baseline for compatibility
+		rolls := CalculateRolls(600, r) // Default baseline for compatibility
 
 		for range rolls {
 			for _, item := range enhancedSupplyDrops {
@@ -243,7 +256,7 @@ func SimulateLootWithSkillsAndPointsAndSeed(rounds int, pointsPerRound int, skil
 		for _, unique := range UniqueRolls {
 			if r.Float64() < unique.Rate {
 				lootCounts[unique.Name] += unique.Quantity
-				if unique.Name != "Phoenix" && unique.Name != "Pyromancer outfit" {
+				if unique.Name != "Pyromancer outfit" {
 					totalValue += unique.Quantity * unique.Value
 				}
 
@@ -279,7 +292,7 @@ func SimulateLootWithSkillsAndPointsAndSeed(rounds int, pointsPerRound int, skil
 	// Simulate reward rolls for each round (based on actual points)
 	for range rounds {
 		// Use actual points per round for accurate roll calculation
-		rolls := CalculateRolls(pointsPerRound)
+		rolls := CalculateRolls(pointsPerRound, r)
 
 		for range rolls {
 			for _, item := range enhancedSupplyDrops {
@@ -0,0 +1,31 @@
+package wintertodt
+
+import (
+	"context"
+	"math"
+
+	"osrs-xp-kits/internal/calculators/tools"
+)
+
+// SimulateLootDistribution runs the loot simulation for many independent trials and
+// summarises the total value and the count of every unique reward
+func SimulateLootDistribution(ctx context.Context, rounds int, pointsPerRound int, skillLevels SkillLevels, livePrices map[string]int, trials int, seed int64) (tools.Distribution, error) {
+	return tools.SimulateDistribution(ctx, trials, rounds*rollsPerRound(pointsPerRound), seed, func(trialSeed int64) tools.Trial {
+		loot, totalValue := SimulateLootWithLivePricesAndSeed(rounds, pointsPerRound, skillLevels, livePrices, trialSeed)
+
+		items := make(map[string]int, len(UniqueRolls))
+		for _, unique := range UniqueRolls {
+			quantity, _ := loot[unique.Name].(int)
+			items[unique.Name] = quantity
+		}
+
+		return tools.Trial{TotalValue: totalValue, Items: items}
+	})
+}
+
+// rollsPerRound is the RNG work of one simulated round: every unique, plus a pass over the
+// supply drops for each reward roll the points earn
+func rollsPerRound(pointsPerRound int) int {
+	rolls, _ := ExpectedRolls(pointsPerRound)
+	return len(UniqueRolls) + int(math.Ceil(rolls))*len(SupplyDrops)
+}
@@ -23,7 +23,7 @@ func SimulateLootWithLivePricesAndSeed(rounds int, pointsPerRound int, skillLeve
 		for _, unique := range UniqueRolls {
 			if r.Float64() < unique.Rate {
 				lootCounts[unique.Name] += unique.Quantity
-				if unique.Name != "Phoenix" && unique.Name != "Pyromancer outfit" {
+				if unique.Name != "Pyromancer outfit" {
 					// Use live price if available, otherwise use default
 					price := unique.Value
 					if livePrice, exists := livePrices[unique.Name]; exists {
@@ -74,7 +74,7 @@ func SimulateLootWithLivePricesAndSeed(rounds int, pointsPerRound int, skillLeve
 	// Simulate reward rolls for each round (based on actual points)
 	for range rounds {
 		// Use actual points per round for accurate roll calculation
-		rolls := CalculateRolls(pointsPerRound)
+		rolls := CalculateRolls(pointsPerRound, r)
 
 		for range rolls {
 			for _, item := range enhancedSupplyDrops {
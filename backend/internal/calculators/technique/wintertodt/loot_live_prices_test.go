@@ -139,7 +139,7 @@ func TestLivePricesVsStaticPrices(t *testing.T) {
 		livePrices[item.Name] = item.Value * 2
 	}
 	for _, item := range UniqueRolls {
-		if item.Name != "Phoenix" && item.Name != "Pyromancer outfit" {
+		if item.Name != "Pyromancer outfit" {
 			livePrices[item.Name] = item.Value * 2
 		}
 	}
@@ -158,7 +158,7 @@ func TestLivePricesVsStaticPrices(t *testing.T) {
 	}
 
 	// Live prices should result in higher total value (roughly double)
-	// Allow for some variance due to Phoenix/Pyromancer outfit not having prices
+	// Allow for some variance due to the Pyromancer outfit not having a price
 	if liveValue <= staticValue {
 		t.Errorf("Live prices should result in higher value: static=%d, live=%d", staticValue, liveValue)
 	}
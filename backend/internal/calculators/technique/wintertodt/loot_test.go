@@ -1,6 +1,7 @@
 package wintertodt
 
 import (
+	"math"
 	"testing"
 )
 
@@ -42,3 +43,57 @@ func TestSimulateLootWithSeedDeterministic(t *testing.T) {
 		t.Errorf("Total values should differ with different seeds, but both are %d", totalValue1)
 	}
 }
+
+// TestExpectedRolls tests the mean and variance of reward rolls per round
+func TestExpectedRolls(t *testing.T) {
+	tests := []struct {
+		points           int
+		expectedMean     float64
+		expectedVariance float64
+	}{
+		{400, 0, 0},
+		{500, 2, 0},
+		{750, 2.5, 0.25},
+		{1000, 3, 0},
+	}
+
+	for _, tt := range tests {
+		mean, variance := ExpectedRolls(tt.points)
+		if math.Abs(mean-tt.expectedMean) > 1e-9 || math.Abs(variance-tt.expectedVariance) > 1e-9 {
+			t.Errorf("ExpectedRolls(%d) = (%.2f, %.2f), expected (%.2f, %.2f)", tt.points, mean, variance, tt.expectedMean, tt.expectedVariance)
+		}
+	}
+}
+
+// TestCalculateExpectedLoot tests exact expected Wintertodt loot
+func TestCalculateExpectedLoot(t *testing.T) {
+	const rounds = 1000
+	skillLevels := SkillLevels{Herblore: 50, Mining: 50, Fishing: 50, Crafting: 50, Farming: 50, Woodcutting: 50}
+
+	loot := CalculateExpectedLoot(rounds, 500, skillLevels, nil)
+
+	quantities := make(map[string]float64)
+	for _, item := range loot.Items {
+		quantities[item.Name] = item.Quantity
+	}
+
+	if quantities["Supply crate"] != rounds {
+		t.Errorf("Expected %d supply crates, got %.2f", rounds, quantities["Supply crate"])
+	}
+	if math.Abs(quantities["Burnt page"]-rounds/45.0) > 1e-9 {
+		t.Errorf("Expected %.4f burnt pages, got %.4f", rounds/45.0, quantities["Burnt page"])
+	}
+
+	// Pure essence is checked after the herbs and diamonds miss: 50 per hit, 2 rolls per round at 500 points
+	essenceChance := 0.2 * (1 - 0.1) * (1 - 0.08) * (1 - 0.05) * (1 - 0.12)
+	if expected := 2 * rounds * essenceChance * 50; math.Abs(quantities["Pure essence"]-expected) > 1e-6 {
+		t.Errorf("Expected %.2f pure essence, got %.2f", expected, quantities["Pure essence"])
+	}
+
+	// Live prices only change value, never quantities
+	livePrices := map[string]int{"Dragon axe": 1}
+	liveLoot := CalculateExpectedLoot(rounds, 500, skillLevels, livePrices)
+	if liveLoot.TotalValue >= loot.TotalValue {
+		t.Errorf("Cheaper live Dragon axe should lower total value: %.0f vs %.0f", liveLoot.TotalValue, loot.TotalValue)
+	}
+}
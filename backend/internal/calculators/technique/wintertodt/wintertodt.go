@@ -3,6 +3,13 @@ package wintertodt
 import (
 	"fmt"
 	"math"
+	"time"
+
+	"osrs-xp-kits/internal/calculators/pets"
+	"osrs-xp-kits/internal/calculators/probability"
+	"osrs-xp-kits/internal/calculators/progression"
+	"osrs-xp-kits/internal/calculators/tools"
+	"osrs-xp-kits/internal/calculators/xp"
 )
 
 type WintertodtResult struct {
@@ -20,6 +27,15 @@ type WintertodtResult struct {
 	PointsPerRound    int            `json:"points_per_round"`
 	MinutesPerRound   float64        `json:"minutes_per_round"`
 	TotalPointsEarned int            `json:"total_points_earned"`
+	Seed              int64          `json:"seed"`
+
+	Progression progression.Progression `json:"progression"`
+	UniqueOdds  []probability.DropOdds  `json:"unique_odds"`
+	PetOdds     pets.Odds               `json:"pet_odds"`
+
+	ExpectedLoot tools.ExpectedLoot `json:"expected_loot"`
+
+	Distribution *tools.Distribution `json:"distribution,omitempty"`
 }
 
 func CalculateWintertodtData(currentLevel, targetLevel int, strategy Strategy, customPointsPerRound *int, customMinutesPerRound *float64, skillLevels SkillLevels) (WintertodtResult, error) {
@@ -28,6 +44,11 @@ func CalculateWintertodtData(currentLevel, targetLevel int, strategy Strategy, c
 
 // CalculateWintertodtDataWithPrices calculates Wintertodt data with optional live prices
 func CalculateWintertodtDataWithPrices(currentLevel, targetLevel int, strategy Strategy, customPointsPerRound *int, customMinutesPerRound *float64, skillLevels SkillLevels, livePrices map[string]int) (WintertodtResult, error) {
+	return CalculateWintertodtDataWithSeed(currentLevel, targetLevel, strategy, customPointsPerRound, customMinutesPerRound, skillLevels, livePrices, time.Now().UnixNano())
+}
+
+// CalculateWintertodtDataWithSeed calculates Wintertodt data with a specific loot seed so results can be reproduced
+func CalculateWintertodtDataWithSeed(currentLevel, targetLevel int, strategy Strategy, customPointsPerRound *int, customMinutesPerRound *float64, skillLevels SkillLevels, livePrices map[string]int, seed int64) (WintertodtResult, error) {
 	if currentLevel < 50 {
 		return WintertodtResult{}, fmt.Errorf("firemaking level must be at least 50")
 	}
@@ -36,6 +57,10 @@ func CalculateWintertodtDataWithPrices(currentLevel, targetLevel int, strategy S
 		return WintertodtResult{}, fmt.Errorf("target level must be greater than or equal to current level")
 	}
 
+	if targetLevel > xp.MaxVirtualLevel {
+		return WintertodtResult{}, fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+
 	// Get strategy data or use custom values
 	strategyInfo, exists := StrategyData[strategy]
 	if !exists {
@@ -47,6 +72,9 @@ func CalculateWintertodtDataWithPrices(currentLevel, targetLevel int, strategy S
 
 	// Override with custom values if provided
 	if customPointsPerRound != nil {
+		if err := validatePointsPerRound(*customPointsPerRound); err != nil {
+			return WintertodtResult{}, err
+		}
 		pointsPerRound = *customPointsPerRound
 	}
 	if customMinutesPerRound != nil {
@@ -54,59 +82,67 @@ func CalculateWintertodtDataWithPrices(currentLevel, targetLevel int, strategy S
 	}
 
 	// Calculate XP needed
-	currentXP := levelToXP(currentLevel)
-	targetXP := levelToXP(targetLevel)
+	currentXP := xp.ForLevel(currentLevel)
+	targetXP := xp.ForLevel(targetLevel)
 	xpNeeded := targetXP - currentXP
 
-	// Experience per round calculation based on reverse engineering osrsportal results
-	// For 50→99: 551 rounds, 12.9M XP = ~23,412 XP per round at average level (~75)
-	// XP scales with level - using quadratic scaling to match expected results
-	avgLevel := float64(currentLevel+targetLevel) / 2.0
-
-	// Base calculation that gives ~23,400 XP per round at level 75
-	baseXPPerRound := 13500.0 + (avgLevel * 95.0) + (avgLevel * avgLevel * 0.55)
-
-	// Apply strategy modifier
-	switch strategy {
-	case StrategyLargeGroup:
-		baseXPPerRound *= 1.0 // Baseline
-	case StrategySolo:
-		baseXPPerRound *= 0.85 // Solo is slower XP/hour
-	case StrategyEfficient:
-		baseXPPerRound *= 0.95 // Slightly less XP per round but faster rounds
+	// Time calculations - add 1 minute buffer between rounds if not using custom time
+	effectiveMinutesPerRound := minutesPerRound
+	if customMinutesPerRound == nil {
+		effectiveMinutesPerRound += 1.0 // Add 1 minute buffer between rounds
 	}
+	roundsPerHour := 60.0 / effectiveMinutesPerRound
 
-	expPerRound := int(math.Floor(baseXPPerRound))
+	// Walk level by level so every round uses the XP of the level it is played at
+	levelProgression, err := progression.Walk(currentXP, targetXP, func(level int) progression.Rate {
+		return progression.Rate{
+			XPPerAction:    xpPerRound(level, strategy),
+			ActionsPerHour: roundsPerHour,
+			SuccessChance:  1,
+		}
+	})
+	if err != nil {
+		return WintertodtResult{}, err
+	}
 
 	// Calculate rounds needed
-	roundsNeeded := int(math.Ceil(float64(xpNeeded) / float64(expPerRound)))
+	roundsNeeded := int(math.Ceil(levelProgression.TotalActions))
 	if roundsNeeded <= 0 {
 		roundsNeeded = 1 // Minimum 1 round for calculation purposes
 	}
 
-	// Calculate total experience (could be more than needed if already at target)
-	totalExp := expPerRound * roundsNeeded
+	// Calculate total experience (could be more than needed since the last round is played in full)
+	expPerRound := levelProgression.AverageXPPerAction()
+	if expPerRound == 0 {
+		expPerRound = xpPerRound(currentLevel, strategy)
+	}
+	totalExp := int(math.Round(expPerRound * float64(roundsNeeded)))
 
-	// Time calculations - add 1 minute buffer between rounds if not using custom time
-	effectiveMinutesPerRound := minutesPerRound
-	if customMinutesPerRound == nil {
-		effectiveMinutesPerRound += 1.0 // Add 1 minute buffer between rounds
+	totalTime := float64(roundsNeeded) / roundsPerHour // Hours
+	avgExpHour := levelProgression.AverageXPPerHour
+
+	if err := tools.ValidateSimulationBudget(1, roundsNeeded*rollsPerRound(pointsPerRound)); err != nil {
+		return WintertodtResult{}, err
 	}
 
-	totalTime := float64(roundsNeeded) * effectiveMinutesPerRound / 60.0 // Convert to hours
-	roundsPerHour := 60.0 / effectiveMinutesPerRound
-	avgExpHour := float64(expPerRound) * roundsPerHour
+	// Pet chance calculation, one roll per supply crate at the level each round is played at
+	petOdds, err := Phoenix.OverProgression(currentXP, levelProgression, 1)
+	if err != nil {
+		return WintertodtResult{}, err
+	}
 
-	// Pet chance calculation (1/5000 per supply crate)
-	petChance := 1.0 - math.Pow(1.0-PetRatePerCrate, float64(roundsNeeded))
+	uniqueOdds, err := CalculateUniqueOdds(roundsNeeded, petOdds.EffectiveRate)
+	if err != nil {
+		return WintertodtResult{}, err
+	}
 
 	// Loot simulation with skill levels and points, using live prices if available
 	var estimatedLoot map[string]any
 	var totalValue int
 	if livePrices != nil {
-		estimatedLoot, totalValue = SimulateLootWithLivePrices(roundsNeeded, pointsPerRound, skillLevels, livePrices)
+		estimatedLoot, totalValue = SimulateLootWithLivePricesAndSeed(roundsNeeded, pointsPerRound, skillLevels, livePrices, seed)
 	} else {
-		estimatedLoot, totalValue = SimulateLootWithSkillsAndPoints(roundsNeeded, pointsPerRound, skillLevels)
+		estimatedLoot, totalValue = SimulateLootWithSkillsAndPointsAndSeed(roundsNeeded, pointsPerRound, skillLevels, seed)
 	}
 
 	// Total points earned
@@ -119,7 +155,8 @@ func CalculateWintertodtDataWithPrices(currentLevel, targetLevel int, strategy S
 		RoundsNeeded:      roundsNeeded,
 		TotalExperience:   totalExp,
 		AverageExpHour:    avgExpHour,
-		PetChance:         petChance * 100, // Convert to percentage
+		PetChance:         petOdds.Chance * 100, // Convert to percentage
+		PetOdds:           petOdds,
 		EstimatedLoot:     estimatedLoot,
 		TotalValue:        totalValue,
 		TotalTime:         totalTime,
@@ -127,19 +164,53 @@ func CalculateWintertodtDataWithPrices(currentLevel, targetLevel int, strategy S
 		PointsPerRound:    pointsPerRound,
 		MinutesPerRound:   minutesPerRound,
 		TotalPointsEarned: totalPointsEarned,
+		Seed:              seed,
+		Progression:       levelProgression,
+		UniqueOdds:        uniqueOdds,
+		ExpectedLoot:      CalculateExpectedLoot(roundsNeeded, pointsPerRound, skillLevels, livePrices),
 	}, nil
 }
 
-// Helper function to convert level to XP
-func levelToXP(level int) int {
-	if level <= 1 {
-		return 0
+// trackedUniques are the rare rewards reported with drop odds besides the Phoenix, one roll per supply crate
+var trackedUniques = []string{"Dragon axe", "Tome of fire"}
+
+// CalculateUniqueOdds returns the odds of the Phoenix and each tracked unique over the given rounds.
+// phoenixRate is the pet chance per crate, which depends on the Firemaking level.
+func CalculateUniqueOdds(rounds int, phoenixRate float64) ([]probability.DropOdds, error) {
+	uniques := []probability.Unique{{Name: Phoenix.Name, Rate: phoenixRate}}
+	for _, name := range trackedUniques {
+		for _, item := range UniqueRolls {
+			if item.Name == name {
+				uniques = append(uniques, probability.Unique{Name: item.Name, Rate: item.Rate})
+			}
+		}
 	}
-	xp := 0
-	for i := 1; i < level; i++ {
-		xp += int(math.Floor(float64(i)+300*math.Pow(2, float64(i)/7.0))) / 4
+	return probability.UniqueOdds(uniques, rounds)
+}
+
+// UniqueDropOdds returns the odds of the tracked uniques
+func (r WintertodtResult) UniqueDropOdds() []probability.DropOdds {
+	return r.UniqueOdds
+}
+
+// xpPerRound returns the Firemaking XP for one round at the given level.
+// Based on reverse engineering osrsportal results: 50→99 takes 551 rounds for 12.9M XP,
+// ~23,400 XP per round at level 75, scaling quadratically with level.
+func xpPerRound(level int, strategy Strategy) float64 {
+	l := float64(level)
+	baseXPPerRound := 13500.0 + (l * 95.0) + (l * l * 0.55)
+
+	// Apply strategy modifier
+	switch strategy {
+	case StrategyLargeGroup:
+		baseXPPerRound *= 1.0 // Baseline
+	case StrategySolo:
+		baseXPPerRound *= 0.85 // Solo is slower XP/hour
+	case StrategyEfficient:
+		baseXPPerRound *= 0.95 // Slightly less XP per round but faster rounds
 	}
-	return xp
+
+	return math.Floor(baseXPPerRound)
 }
 
 // GetCalculationProTips provides detailed information about how Wintertodt calculations work
@@ -212,3 +283,11 @@ func GetCalculationProTips() map[string]any {
 		},
 	}
 }
+
+// validatePointsPerRound checks custom points per round, which drive the supply rolls of every round
+func validatePointsPerRound(points int) error {
+	if points < 0 || points > MaxPointsPerRound {
+		return fmt.Errorf("points per round must be between 0 and %d", MaxPointsPerRound)
+	}
+	return nil
+}
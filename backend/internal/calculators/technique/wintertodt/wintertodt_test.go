@@ -1,6 +1,8 @@
 package wintertodt
 
 import (
+	"context"
+	"math"
 	"testing"
 )
 
@@ -162,26 +164,6 @@ func TestCalculateWintertodtDataWithCustomParams(t *testing.T) {
 	}
 }
 
-func TestLevelToXP(t *testing.T) {
-	tests := []struct {
-		level      int
-		expectedXP int
-	}{
-		{1, 0},
-		{50, 101314}, // Actual calculated values
-		{99, 13034394},
-	}
-
-	for _, tt := range tests {
-		t.Run("Level "+string(rune(tt.level)), func(t *testing.T) {
-			xp := levelToXP(tt.level)
-			if xp != tt.expectedXP {
-				t.Errorf("Expected XP %d for level %d, got %d", tt.expectedXP, tt.level, xp)
-			}
-		})
-	}
-}
-
 func BenchmarkCalculateWintertodtData(b *testing.B) {
 	skillLevels := SkillLevels{
 		Herblore:    70,
@@ -196,3 +178,85 @@ func BenchmarkCalculateWintertodtData(b *testing.B) {
 		CalculateWintertodtData(75, 99, StrategyLargeGroup, nil, nil, skillLevels)
 	}
 }
+
+func TestCalculateWintertodtDataWithSeed(t *testing.T) {
+	skillLevels := SkillLevels{Herblore: 80, Mining: 80, Fishing: 80, Crafting: 80, Farming: 80, Woodcutting: 80}
+
+	// 750 points gives a fractional extra roll, which must also follow the seed
+	points := 750
+	first, err := CalculateWintertodtDataWithSeed(50, 99, StrategySolo, &points, nil, skillLevels, nil, 42)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	second, err := CalculateWintertodtDataWithSeed(50, 99, StrategySolo, &points, nil, skillLevels, nil, 42)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if first.Seed != 42 {
+		t.Errorf("Expected seed 42 in result, got %d", first.Seed)
+	}
+	if first.TotalValue != second.TotalValue {
+		t.Errorf("Same seed should produce same total value: %d vs %d", first.TotalValue, second.TotalValue)
+	}
+	for name, quantity := range first.EstimatedLoot {
+		if second.EstimatedLoot[name] != quantity {
+			t.Errorf("Same seed should produce same loot for %s: %v vs %v", name, quantity, second.EstimatedLoot[name])
+		}
+	}
+}
+
+func TestWintertodtPetOddsScaleWithLevel(t *testing.T) {
+	skillLevels := SkillLevels{Herblore: 80, Mining: 80, Fishing: 80, Crafting: 80, Farming: 80, Woodcutting: 80}
+
+	result, err := CalculateWintertodtDataWithSeed(70, 99, StrategyLargeGroup, nil, nil, skillLevels, nil, 42)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// A flat 1/5000 per crate over the same rounds is the floor the level scaling improves on
+	flat := 1 - math.Pow(1-1.0/5000, result.PetOdds.Rolls)
+	if result.PetOdds.Chance <= flat {
+		t.Errorf("Level-scaled pet chance %.4f should beat the flat rate %.4f", result.PetOdds.Chance, flat)
+	}
+	if result.PetChance != result.PetOdds.Chance*100 {
+		t.Errorf("Pet chance percentage %.4f does not match the pet odds", result.PetChance)
+	}
+	if result.UniqueOdds[0].Name != "Phoenix" || result.UniqueOdds[0].Rate != result.PetOdds.EffectiveRate {
+		t.Errorf("Phoenix unique odds should use the level-scaled rate, got %+v", result.UniqueOdds[0])
+	}
+
+	// The Phoenix comes only from the pet model, never from a flat loot roll
+	if _, exists := result.EstimatedLoot["Phoenix"]; exists {
+		t.Errorf("Simulated loot should not roll the Phoenix at a flat rate")
+	}
+	for _, item := range result.ExpectedLoot.Items {
+		if item.Name == "Phoenix" {
+			t.Errorf("Expected loot should not include the Phoenix at a flat rate")
+		}
+	}
+}
+
+func TestCustomPointsPerRoundBounds(t *testing.T) {
+	for _, points := range []int{-1, MaxPointsPerRound + 1, 1 << 40} {
+		if _, err := CalculateWintertodtDataWithSeed(50, 60, StrategySolo, &points, nil, SkillLevels{}, nil, 1); err == nil {
+			t.Errorf("Expected an error for %d points per round", points)
+		}
+		if err := (Calculator{}).Validate(WintertodtInput{CurrentLevel: 50, TargetLevel: 60, Strategy: "solo", CustomPointsPerRound: &points}); err == nil {
+			t.Errorf("Expected Validate to reject %d points per round", points)
+		}
+	}
+
+	points := MaxPointsPerRound
+	if _, err := CalculateWintertodtDataWithSeed(50, 126, StrategySolo, &points, nil, SkillLevels{}, nil, 1); err != nil {
+		t.Errorf("Unexpected error at the maximum points per round: %v", err)
+	}
+}
+
+func TestDistributionBudgetCountsSupplyRolls(t *testing.T) {
+	// 400 rounds fit the budget when only the uniques are counted, but not with 28 supply rolls a round
+	_, err := SimulateLootDistribution(context.Background(), 400, MaxPointsPerRound, SkillLevels{}, nil, 10000, 1)
+	if err == nil {
+		t.Error("Expected the supply rolls to count towards the simulation budget")
+	}
+}
@@ -0,0 +1,44 @@
+package zalcano
+
+import (
+	"osrs-xp-kits/internal/calculators"
+)
+
+// ZalcanoInput is the request body accepted by the Zalcano calculator.
+// The levels are Mining levels; Smithing and Runecraft XP are gained alongside.
+type ZalcanoInput struct {
+	CurrentLevel             int      `json:"current_level"`
+	TargetLevel              int      `json:"target_level"`
+	TeamSize                 string   `json:"team_size"`
+	CustomContributionPoints *int     `json:"custom_contribution_points,omitempty"`
+	CustomKillsPerHour       *float64 `json:"custom_kills_per_hour,omitempty"`
+}
+
+// Calculator exposes Zalcano through the calculators.Calculator interface
+type Calculator struct{}
+
+// Metadata describes the Zalcano calculator
+func (Calculator) Metadata() calculators.Metadata {
+	return calculators.Metadata{
+		ID:          "zalcano",
+		Name:        "Zalcano",
+		Description: "Mining, Smithing and Runecraft XP per kill, loot by contribution, and crystal tool seed and Smolcano odds at Zalcano",
+		Category:    "minigame",
+		Skills:      []string{"mining", "smithing", "runecraft"},
+	}
+}
+
+// Validate checks the Zalcano input
+func (Calculator) Validate(input ZalcanoInput) error {
+	return validateInput(input)
+}
+
+// Calculate runs the Zalcano calculation
+func (Calculator) Calculate(input ZalcanoInput, opts calculators.Options) (ZalcanoResult, error) {
+	return CalculateZalcanoDataWithPrices(input, opts.Prices)
+}
+
+// ProTips returns the Zalcano calculation methodology
+func (Calculator) ProTips() map[string]any {
+	return GetCalculationProTips()
+}
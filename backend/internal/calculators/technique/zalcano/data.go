@@ -0,0 +1,90 @@
+package zalcano
+
+type LootItem struct {
+	Name     string  `json:"name"`
+	Quantity int     `json:"quantity"`
+	Value    int     `json:"value"` // Default price when live prices are unavailable
+	Rate     float64 `json:"rate"`  // Chance per roll
+}
+
+// SupplyDrops are rolled once per loot roll, and a player gets more rolls with more contribution
+var SupplyDrops = []LootItem{
+	{"Runite ore", 4, 10500, 0.12},
+	{"Adamantite ore", 12, 1100, 0.18},
+	{"Coal", 40, 150, 0.2},
+	{"Uncut diamond", 3, 2800, 0.15},
+	{"Uncut ruby", 4, 1000, 0.15},
+	{"Uncut dragonstone", 1, 9000, 0.05},
+}
+
+// Uniques are rolled once per kill, scaled by the player's share of a full contribution
+var Uniques = []LootItem{
+	{"Crystal tool seed", 1, 0, 1.0 / 200.0}, // 1/200 (untradeable)
+	{"Zalcano shard", 1, 0, 1.0 / 1500.0},    // 1/1,500 (untradeable)
+	{"Smolcano", 1, 0, 1.0 / 2250.0},         // 1/2,250 pet
+}
+
+// TeamSize is a preset for the number of players fighting Zalcano
+type TeamSize string
+
+const (
+	TeamSolo TeamSize = "solo"
+	TeamDuo  TeamSize = "duo"
+	TeamFour TeamSize = "team_4"
+	TeamMass TeamSize = "mass"
+)
+
+// TeamData holds each preset's pace and what one player does per kill
+var TeamData = map[TeamSize]struct {
+	KillsPerHour float64
+	// ContributionPoints is one player's points per kill. MaxContributionPoints is a full share.
+	ContributionPoints int
+	// TephraPerKill is the tephra one player mines, refines and imbues each kill
+	TephraPerKill float64
+	Description   string
+	Requirements  []string
+}{
+	TeamSolo: {
+		KillsPerHour:       11,
+		ContributionPoints: 1000,
+		TephraPerKill:      60,
+		Description:        "Solo kills for full loot and unique chances",
+		Requirements:       []string{"Level 70 Mining", "Level 70 Smithing", "Song of the Elves", "Good gear"},
+	},
+	TeamDuo: {
+		KillsPerHour:       16,
+		ContributionPoints: 700,
+		TephraPerKill:      40,
+		Description:        "Duo kills with a steady partner",
+		Requirements:       []string{"Level 70 Mining", "Level 70 Smithing", "Song of the Elves"},
+	},
+	TeamFour: {
+		KillsPerHour:       22,
+		ContributionPoints: 450,
+		TephraPerKill:      25,
+		Description:        "Small team balancing speed and loot",
+		Requirements:       []string{"Level 70 Mining", "Level 70 Smithing", "Song of the Elves"},
+	},
+	TeamMass: {
+		KillsPerHour:       28,
+		ContributionPoints: 250,
+		TephraPerKill:      14,
+		Description:        "Mass world kills for the fastest, most AFK XP",
+		Requirements:       []string{"Level 70 Mining", "Level 70 Smithing", "Song of the Elves"},
+	},
+}
+
+// Zalcano constants based on OSRS Wiki
+const (
+	MinimumMiningLevel = 70
+
+	// XP per tephra mined, refined into a tephra brick and imbued at an altar
+	MiningXPPerTephra    = 48.0
+	SmithingXPPerTephra  = 36.0
+	RunecraftXPPerTephra = 18.0
+
+	// MaxContributionPoints is a full share of the unique rolls
+	MaxContributionPoints = 1000
+	// PointsPerLootRoll is the contribution needed for each supply roll
+	PointsPerLootRoll = 250
+)
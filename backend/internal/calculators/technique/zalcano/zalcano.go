@@ -0,0 +1,227 @@
+package zalcano
+
+import (
+	"fmt"
+	"math"
+
+	"osrs-xp-kits/internal/calculators/probability"
+	"osrs-xp-kits/internal/calculators/progression"
+	"osrs-xp-kits/internal/calculators/tools"
+	"osrs-xp-kits/internal/calculators/xp"
+)
+
+type ZalcanoResult struct {
+	CurrentLevel int    `json:"current_level"`
+	TargetLevel  int    `json:"target_level"`
+	XPNeeded     int    `json:"xp_needed"`
+	TeamSize     string `json:"team_size"`
+
+	KillsPerHour       float64 `json:"kills_per_hour"`
+	ContributionPoints int     `json:"contribution_points"`
+	ContributionShare  float64 `json:"contribution_share"` // Share of a full unique roll
+	LootRollsPerKill   float64 `json:"loot_rolls_per_kill"`
+
+	XPPerKill map[string]float64 `json:"xp_per_kill"`
+	XPPerHour map[string]float64 `json:"xp_per_hour"`
+
+	KillsNeeded int                `json:"kills_needed"`
+	HoursNeeded float64            `json:"hours_needed"`
+	TotalXP     map[string]float64 `json:"total_xp"`
+
+	ExpectedLoot []LootValue `json:"expected_loot"` // Supply drops over every kill
+	ValuePerKill float64     `json:"value_per_kill"`
+	GPPerHour    int         `json:"gp_per_hour"`
+	TotalValue   int         `json:"total_value"`
+
+	UniqueOdds  []probability.DropOdds  `json:"unique_odds"`
+	Progression progression.Progression `json:"progression"`
+}
+
+// LootValue is the expected quantity and value of one supply drop
+type LootValue struct {
+	Name     string  `json:"name"`
+	Quantity float64 `json:"quantity"`
+	Price    int     `json:"price"`
+	Value    int     `json:"value"`
+}
+
+// UniqueDropOdds returns the odds of the crystal tool seed, Zalcano shard and Smolcano
+func (r ZalcanoResult) UniqueDropOdds() []probability.DropOdds {
+	return r.UniqueOdds
+}
+
+func CalculateZalcanoData(input ZalcanoInput) (ZalcanoResult, error) {
+	return CalculateZalcanoDataWithPrices(input, nil)
+}
+
+// CalculateZalcanoDataWithPrices calculates Zalcano data with optional live loot prices
+func CalculateZalcanoDataWithPrices(input ZalcanoInput, livePrices map[string]int) (ZalcanoResult, error) {
+	if err := validateInput(input); err != nil {
+		return ZalcanoResult{}, err
+	}
+
+	// Get team data or use custom values
+	teamInfo := TeamData[TeamSize(input.TeamSize)]
+	killsPerHour := teamInfo.KillsPerHour
+	contributionPoints := teamInfo.ContributionPoints
+	if input.CustomKillsPerHour != nil {
+		killsPerHour = *input.CustomKillsPerHour
+	}
+	if input.CustomContributionPoints != nil {
+		contributionPoints = *input.CustomContributionPoints
+	}
+
+	// Tephra handled scales with contribution, using the preset's tephra per point
+	tephraPerKill := teamInfo.TephraPerKill * float64(contributionPoints) / float64(teamInfo.ContributionPoints)
+	xpPerKill := map[string]float64{
+		"mining":    tephraPerKill * MiningXPPerTephra,
+		"smithing":  tephraPerKill * SmithingXPPerTephra,
+		"runecraft": tephraPerKill * RunecraftXPPerTephra,
+	}
+
+	currentXP := xp.ForLevel(input.CurrentLevel)
+	targetXP := xp.ForLevel(input.TargetLevel)
+	levelProgression, err := progression.Walk(currentXP, targetXP, func(level int) progression.Rate {
+		return progression.Rate{
+			XPPerAction:    xpPerKill["mining"],
+			ActionsPerHour: killsPerHour,
+			SuccessChance:  1,
+		}
+	})
+	if err != nil {
+		return ZalcanoResult{}, err
+	}
+	kills := int(math.Ceil(levelProgression.TotalActions))
+
+	xpPerHour := make(map[string]float64, len(xpPerKill))
+	totalXP := make(map[string]float64, len(xpPerKill))
+	for skill, amount := range xpPerKill {
+		xpPerHour[skill] = amount * killsPerHour
+		totalXP[skill] = amount * levelProgression.TotalActions
+	}
+
+	lootRolls := float64(contributionPoints) / PointsPerLootRoll
+	expectedLoot := make([]LootValue, 0, len(SupplyDrops))
+	valuePerKill := 0.0
+	for _, drop := range SupplyDrops {
+		price := tools.PriceOr(drop.Name, drop.Value, livePrices)
+		perKill := lootRolls * drop.Rate * float64(drop.Quantity)
+		valuePerKill += perKill * float64(price)
+		expectedLoot = append(expectedLoot, LootValue{
+			Name:     drop.Name,
+			Quantity: perKill * float64(kills),
+			Price:    price,
+			Value:    int(math.Round(perKill * float64(kills) * float64(price))),
+		})
+	}
+
+	contributionShare := math.Min(1, float64(contributionPoints)/MaxContributionPoints)
+	uniques := make([]probability.Unique, 0, len(Uniques))
+	for _, unique := range Uniques {
+		uniques = append(uniques, probability.Unique{Name: unique.Name, Rate: unique.Rate * contributionShare})
+	}
+	uniqueOdds, err := probability.UniqueOdds(uniques, kills)
+	if err != nil {
+		return ZalcanoResult{}, err
+	}
+
+	return ZalcanoResult{
+		CurrentLevel:       input.CurrentLevel,
+		TargetLevel:        input.TargetLevel,
+		XPNeeded:           targetXP - currentXP,
+		TeamSize:           input.TeamSize,
+		KillsPerHour:       killsPerHour,
+		ContributionPoints: contributionPoints,
+		ContributionShare:  contributionShare,
+		LootRollsPerKill:   lootRolls,
+		XPPerKill:          xpPerKill,
+		XPPerHour:          xpPerHour,
+		KillsNeeded:        kills,
+		HoursNeeded:        levelProgression.TotalHours,
+		TotalXP:            totalXP,
+		ExpectedLoot:       expectedLoot,
+		ValuePerKill:       valuePerKill,
+		GPPerHour:          int(math.Round(valuePerKill * killsPerHour)),
+		TotalValue:         int(math.Round(valuePerKill * float64(kills))),
+		UniqueOdds:         uniqueOdds,
+		Progression:        levelProgression,
+	}, nil
+}
+
+// validateInput checks the levels, team size and custom values
+func validateInput(input ZalcanoInput) error {
+	if input.CurrentLevel < MinimumMiningLevel || input.CurrentLevel > xp.MaxLevel {
+		return fmt.Errorf("mining level must be between %d and %d", MinimumMiningLevel, xp.MaxLevel)
+	}
+	if input.TargetLevel < input.CurrentLevel {
+		return fmt.Errorf("target level must be greater than or equal to current level")
+	}
+	if input.TargetLevel > xp.MaxVirtualLevel {
+		return fmt.Errorf("target level must be at most %d", xp.MaxVirtualLevel)
+	}
+	if _, exists := TeamData[TeamSize(input.TeamSize)]; !exists {
+		return fmt.Errorf("invalid team size: %s", input.TeamSize)
+	}
+	if input.CustomContributionPoints != nil && (*input.CustomContributionPoints <= 0 || *input.CustomContributionPoints > MaxContributionPoints) {
+		return fmt.Errorf("contribution points must be between 1 and %d", MaxContributionPoints)
+	}
+	if input.CustomKillsPerHour != nil && *input.CustomKillsPerHour <= 0 {
+		return fmt.Errorf("kills per hour must be greater than 0")
+	}
+	return nil
+}
+
+func GetCalculationProTips() map[string]any {
+	return map[string]any{
+		"calculation_methodology": map[string]any{
+			"xp_rates_source": "Tephra XP from the OSRS Wiki, kills per hour and contribution from community team testing",
+			"base_formula":    "XP per kill in each skill = tephra handled per kill × XP per tephra, scaled by contribution points",
+			"data_points": []map[string]any{
+				{"team_size": "solo", "kills_per_hour": 11, "mining": 31700, "smithing": 23800, "runecraft": 11900},
+				{"team_size": "team_4", "kills_per_hour": 22, "mining": 26400, "smithing": 19800, "runecraft": 9900},
+				{"team_size": "mass", "kills_per_hour": 28, "mining": 18800, "smithing": 14100, "runecraft": 7100},
+			},
+		},
+		"game_mechanics": map[string]any{
+			"tephra":       "Mining tephra gives Mining XP, refining it at the furnace gives Smithing XP and imbuing it at the altar gives Runecraft XP",
+			"contribution": "Mining, refining, imbuing and damaging Zalcano earn contribution points, capped at 1,000 per kill",
+			"loot":         "Every 250 contribution points is one roll on the supply table",
+			"uniques":      "The crystal tool seed, Zalcano shard and Smolcano are rolled at the full rate with 1,000 points",
+		},
+		"factors_considered": []string{
+			"Team size and kills per hour",
+			"Contribution points per kill",
+			"Tephra handled per kill",
+			"Supply drop prices",
+			"Unique rates scaled by contribution",
+		},
+		"accuracy_notes": map[string]any{
+			"rates_vary": "Kill times and contribution depend on the team:",
+			"variance_factors": []string{
+				"Damage dealt with imbued tephra",
+				"Time lost to falling rocks and the glowing symbols",
+				"How the team splits mining, refining and imbuing",
+			},
+			"calculation_basis": "Every kill handles the same tephra and earns the team preset's contribution",
+		},
+		"pro_tips": []map[string]string{
+			{
+				"tip":         "Play Solo for Uniques",
+				"description": "Only a full 1,000 contribution points rolls the crystal tool seed and Smolcano at the full rate",
+			},
+			{
+				"tip":         "Teams for Kills per Hour",
+				"description": "Teams kill Zalcano faster but split the tephra, lowering the XP and loot per kill",
+			},
+			{
+				"tip":         "Dodge the Symbols",
+				"description": "Standing on the glowing symbols when they turn red costs hitpoints and contribution",
+			},
+		},
+		"reward_calculation": map[string]any{
+			"supply_drops":      "Expected ores and gems are valued at live Grand Exchange prices when available",
+			"crystal_tool_seed": "1/200 per kill at full contribution",
+			"smolcano":          "1/2,250 per kill at full contribution",
+		},
+	}
+}
@@ -0,0 +1,121 @@
+package zalcano
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalculateZalcanoData(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       ZalcanoInput
+		expectError bool
+	}{
+		{
+			name:  "Solo kills",
+			input: ZalcanoInput{CurrentLevel: 70, TargetLevel: 80, TeamSize: "solo"},
+		},
+		{
+			name:  "Team of four",
+			input: ZalcanoInput{CurrentLevel: 80, TargetLevel: 90, TeamSize: "team_4"},
+		},
+		{
+			name:  "Mass world",
+			input: ZalcanoInput{CurrentLevel: 90, TargetLevel: 99, TeamSize: "mass"},
+		},
+		{
+			name:        "Mining level too low",
+			input:       ZalcanoInput{CurrentLevel: 60, TargetLevel: 80, TeamSize: "solo"},
+			expectError: true,
+		},
+		{
+			name:        "Invalid team size",
+			input:       ZalcanoInput{CurrentLevel: 70, TargetLevel: 80, TeamSize: "raid"},
+			expectError: true,
+		},
+		{
+			name:        "Target below current",
+			input:       ZalcanoInput{CurrentLevel: 80, TargetLevel: 75, TeamSize: "duo"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculateZalcanoData(tt.input)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			for _, skill := range []string{"mining", "smithing", "runecraft"} {
+				if result.TotalXP[skill] <= 0 || result.XPPerHour[skill] <= 0 {
+					t.Errorf("Expected %s XP, got %f total and %f per hour", skill, result.TotalXP[skill], result.XPPerHour[skill])
+				}
+			}
+			if math.Abs(result.TotalXP["mining"]-float64(result.XPNeeded)) > 1e-6 {
+				t.Errorf("Mining XP %f should equal the XP needed %d", result.TotalXP["mining"], result.XPNeeded)
+			}
+			if result.GPPerHour <= 0 {
+				t.Errorf("Expected positive GP per hour, got %d", result.GPPerHour)
+			}
+		})
+	}
+}
+
+func TestContributionScalesLootAndUniques(t *testing.T) {
+	solo, err := CalculateZalcanoData(ZalcanoInput{CurrentLevel: 80, TargetLevel: 85, TeamSize: "solo"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	mass, err := CalculateZalcanoData(ZalcanoInput{CurrentLevel: 80, TargetLevel: 85, TeamSize: "mass"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if solo.LootRollsPerKill != 4 || mass.LootRollsPerKill != 1 {
+		t.Errorf("Expected 4 and 1 loot rolls, got %.1f and %.1f", solo.LootRollsPerKill, mass.LootRollsPerKill)
+	}
+	if solo.ValuePerKill <= mass.ValuePerKill {
+		t.Errorf("Full contribution should be worth more per kill: %.0f vs %.0f", solo.ValuePerKill, mass.ValuePerKill)
+	}
+	if solo.UniqueOdds[0].Name != "Crystal tool seed" || solo.UniqueOdds[0].Rate != 1.0/200 {
+		t.Errorf("Expected the full crystal tool seed rate, got %+v", solo.UniqueOdds[0])
+	}
+	if mass.UniqueOdds[0].Rate != 0.25/200 {
+		t.Errorf("Expected a quarter of the seed rate, got %f", mass.UniqueOdds[0].Rate)
+	}
+}
+
+func TestCustomTeamValues(t *testing.T) {
+	points := 500
+	killsPerHour := 20.0
+	input := ZalcanoInput{CurrentLevel: 80, TargetLevel: 85, TeamSize: "solo", CustomContributionPoints: &points, CustomKillsPerHour: &killsPerHour}
+
+	result, err := CalculateZalcanoDataWithPrices(input, map[string]int{"Runite ore": 12000})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result.KillsPerHour != killsPerHour || result.ContributionPoints != points {
+		t.Errorf("Expected custom values, got %.1f kills per hour and %d points", result.KillsPerHour, result.ContributionPoints)
+	}
+	if result.ExpectedLoot[0].Price != 12000 {
+		t.Errorf("Expected the live runite ore price, got %d", result.ExpectedLoot[0].Price)
+	}
+	if math.Abs(result.XPPerKill["mining"]-30*MiningXPPerTephra) > 1e-6 {
+		t.Errorf("Half contribution should halve the tephra, got %f Mining XP per kill", result.XPPerKill["mining"])
+	}
+
+	points = 1500
+	if _, err := CalculateZalcanoData(input); err == nil {
+		t.Errorf("Expected error for contribution above the cap")
+	}
+}
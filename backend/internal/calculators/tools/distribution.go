@@ -0,0 +1,208 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+const (
+	// MaxSimulations caps the number of trials a single request may run
+	MaxSimulations = 10000
+	// MaxSimulatedRolls caps the total work of a request: trials × rolls per trial
+	MaxSimulatedRolls = 20_000_000
+	// HistogramBuckets is the number of buckets in every histogram
+	HistogramBuckets = 20
+)
+
+// Trial is the outcome of one simulated run
+type Trial struct {
+	TotalValue int
+	// Items holds the counts that should be summarised, keyed by item name
+	Items map[string]int
+}
+
+// TrialFunc runs one simulation using the given seed
+type TrialFunc func(seed int64) Trial
+
+// HistogramBucket counts the samples falling in [Min, Max)
+type HistogramBucket struct {
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+	Count int     `json:"count"`
+}
+
+// Summary describes the spread of a sampled quantity
+type Summary struct {
+	Mean      float64           `json:"mean"`
+	Median    float64           `json:"median"`
+	P10       float64           `json:"p10"`
+	P90       float64           `json:"p90"`
+	Min       float64           `json:"min"`
+	Max       float64           `json:"max"`
+	Histogram []HistogramBucket `json:"histogram"`
+}
+
+// Distribution summarises the results of many independent trials
+type Distribution struct {
+	Trials     int                `json:"trials"`
+	Seed       int64              `json:"seed"`
+	TotalValue Summary            `json:"total_value"`
+	Items      map[string]Summary `json:"items"`
+}
+
+// ValidateSimulations checks a requested number of trials. Zero means the mode is disabled.
+func ValidateSimulations(trials int) error {
+	if trials < 0 || trials > MaxSimulations {
+		return fmt.Errorf("simulations must be between 0 and %d, got %d", MaxSimulations, trials)
+	}
+	return nil
+}
+
+// ValidateSimulationBudget checks that trials × rollsPerTrial fits in MaxSimulatedRolls.
+// Rolls per trial grow with the level range, so the trial cap alone does not bound the work.
+func ValidateSimulationBudget(trials, rollsPerTrial int) error {
+	if rollsPerTrial <= 0 || trials <= MaxSimulatedRolls/rollsPerTrial {
+		return nil
+	}
+	if rollsPerTrial > MaxSimulatedRolls {
+		return fmt.Errorf("simulation too large: %d rolls per trial exceeds the budget of %d, narrow the level range", rollsPerTrial, MaxSimulatedRolls)
+	}
+	return fmt.Errorf("simulation too large: %d trials of %d rolls exceeds the budget of %d, use at most %d simulations", trials, rollsPerTrial, MaxSimulatedRolls, MaxSimulatedRolls/rollsPerTrial)
+}
+
+// SimulateDistribution runs trials in parallel across goroutines and summarises them.
+// Every trial gets its own seed derived from seed, so the result does not depend on scheduling.
+// rollsPerTrial is checked against the budget, and the workers stop once ctx is cancelled.
+func SimulateDistribution(ctx context.Context, trials, rollsPerTrial int, seed int64, run TrialFunc) (Distribution, error) {
+	if trials <= 0 || trials > MaxSimulations {
+		return Distribution{}, fmt.Errorf("simulations must be between 1 and %d, got %d", MaxSimulations, trials)
+	}
+	if err := ValidateSimulationBudget(trials, rollsPerTrial); err != nil {
+		return Distribution{}, err
+	}
+
+	// Derive trial seeds up front so the outcome is reproducible
+	seeder := rand.New(rand.NewSource(seed))
+	seeds := make([]int64, trials)
+	for i := range seeds {
+		seeds[i] = seeder.Int63()
+	}
+
+	results := make([]Trial, trials)
+	workers := min(runtime.GOMAXPROCS(0), trials)
+
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func(start int) {
+			defer wg.Done()
+			for i := start; i < trials; i += workers {
+				if ctx.Err() != nil {
+					return
+				}
+				results[i] = run(seeds[i])
+			}
+		}(w)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return Distribution{}, fmt.Errorf("simulation cancelled: %w", err)
+	}
+
+	// Collect every item seen in any trial; trials without it count as zero
+	itemNames := make(map[string]struct{})
+	totals := make([]float64, trials)
+	for i, trial := range results {
+		totals[i] = float64(trial.TotalValue)
+		for name := range trial.Items {
+			itemNames[name] = struct{}{}
+		}
+	}
+
+	items := make(map[string]Summary, len(itemNames))
+	for name := range itemNames {
+		counts := make([]float64, trials)
+		for i, trial := range results {
+			counts[i] = float64(trial.Items[name])
+		}
+		items[name] = Summarize(counts)
+	}
+
+	return Distribution{
+		Trials:     trials,
+		Seed:       seed,
+		TotalValue: Summarize(totals),
+		Items:      items,
+	}, nil
+}
+
+// Summarize computes the mean, percentiles and histogram of a sample
+func Summarize(values []float64) Summary {
+	if len(values) == 0 {
+		return Summary{}
+	}
+
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	sum := 0.0
+	for _, v := range sorted {
+		sum += v
+	}
+
+	return Summary{
+		Mean:      sum / float64(len(sorted)),
+		Median:    median(sorted),
+		P10:       percentile(sorted, 0.10),
+		P90:       percentile(sorted, 0.90),
+		Min:       sorted[0],
+		Max:       sorted[len(sorted)-1],
+		Histogram: histogram(sorted, HistogramBuckets),
+	}
+}
+
+// median returns the middle value of a sorted sample
+func median(sorted []float64) float64 {
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+// percentile returns the nearest-rank percentile of a sorted sample
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	rank = max(0, min(rank, len(sorted)-1))
+	return sorted[rank]
+}
+
+// histogram splits a sorted sample into equal-width buckets between its min and max.
+// The last bucket includes the maximum value.
+func histogram(sorted []float64, buckets int) []HistogramBucket {
+	lo, hi := sorted[0], sorted[len(sorted)-1]
+	if lo == hi {
+		return []HistogramBucket{{Min: lo, Max: hi, Count: len(sorted)}}
+	}
+
+	width := (hi - lo) / float64(buckets)
+	result := make([]HistogramBucket, buckets)
+	for i := range result {
+		result[i].Min = lo + float64(i)*width
+		result[i].Max = lo + float64(i+1)*width
+	}
+	result[buckets-1].Max = hi
+
+	for _, v := range sorted {
+		idx := min(int((v-lo)/width), buckets-1)
+		result[idx].Count++
+	}
+
+	return result
+}
@@ -0,0 +1,160 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// TestSimulateDistributionDeterministic checks that the same seed gives the same distribution
+func TestSimulateDistributionDeterministic(t *testing.T) {
+	testDropTable := DropTable{
+		{Name: "Ranarr seed", Probability: 0.5, Price: 100},
+		{Name: "Snapdragon seed", Probability: 0.3, Price: 200},
+		{Name: "Torstol seed", Probability: 0.2, Price: 300},
+	}
+
+	run := func(seed int64) Trial {
+		results, totalValue, _ := SimulateMultipleDropsWithSeed(testDropTable, 50, seed)
+		items := make(map[string]int, len(results))
+		for name, data := range results {
+			items[name] = data["quantity"]
+		}
+		return Trial{TotalValue: totalValue, Items: items}
+	}
+
+	first, err := SimulateDistribution(context.Background(), 500, 50, 42, run)
+	if err != nil {
+		t.Fatalf("First simulation failed: %v", err)
+	}
+	second, err := SimulateDistribution(context.Background(), 500, 50, 42, run)
+	if err != nil {
+		t.Fatalf("Second simulation failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("Distributions differ for the same seed")
+	}
+
+	if first.Trials != 500 || first.Seed != 42 {
+		t.Errorf("Expected 500 trials with seed 42, got %d trials with seed %d", first.Trials, first.Seed)
+	}
+
+	for _, key := range []string{"ranarr", "snapdragon", "torstol"} {
+		if _, ok := first.Items[key]; !ok {
+			t.Errorf("Item %s missing from distribution", key)
+		}
+	}
+
+	total := first.TotalValue
+	if total.Min > total.P10 || total.P10 > total.Median || total.Median > total.P90 || total.P90 > total.Max {
+		t.Errorf("Percentiles out of order: %+v", total)
+	}
+}
+
+// TestSimulateDistributionInvalidTrials checks the trial bounds
+func TestSimulateDistributionInvalidTrials(t *testing.T) {
+	run := func(seed int64) Trial { return Trial{} }
+
+	for _, trials := range []int{0, -1, MaxSimulations + 1} {
+		if _, err := SimulateDistribution(context.Background(), trials, 1, 1, run); err == nil {
+			t.Errorf("Expected error for %d trials", trials)
+		}
+	}
+
+	if err := ValidateSimulations(0); err != nil {
+		t.Errorf("Zero simulations should be valid (disabled), got %v", err)
+	}
+	if err := ValidateSimulations(MaxSimulations + 1); err == nil {
+		t.Errorf("Expected error above MaxSimulations")
+	}
+}
+
+// TestSimulateDistributionBudget checks that trials × rolls per trial is capped
+func TestSimulateDistributionBudget(t *testing.T) {
+	run := func(seed int64) Trial { return Trial{} }
+
+	if _, err := SimulateDistribution(context.Background(), 100, MaxSimulatedRolls/100, 1, run); err != nil {
+		t.Errorf("Simulation within the budget should run, got %v", err)
+	}
+	if _, err := SimulateDistribution(context.Background(), 101, MaxSimulatedRolls/100, 1, run); err == nil {
+		t.Errorf("Expected error above the roll budget")
+	}
+	if err := ValidateSimulationBudget(1, MaxSimulatedRolls+1); err == nil {
+		t.Errorf("Expected error for a single trial above the roll budget")
+	}
+}
+
+// TestSimulateDistributionCancelled checks that a cancelled context stops the workers
+func TestSimulateDistributionCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	ran := 0
+	var mu sync.Mutex
+	run := func(seed int64) Trial {
+		mu.Lock()
+		ran++
+		mu.Unlock()
+		return Trial{}
+	}
+
+	if _, err := SimulateDistribution(ctx, 1000, 1, 1, run); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if ran != 0 {
+		t.Errorf("Expected no trials after cancellation, ran %d", ran)
+	}
+}
+
+// TestSummarize tests the summary statistics on a known sample
+func TestSummarize(t *testing.T) {
+	values := []float64{10, 1, 9, 2, 8, 3, 7, 4, 6, 5}
+	summary := Summarize(values)
+
+	tests := []struct {
+		name     string
+		got      float64
+		expected float64
+	}{
+		{"mean", summary.Mean, 5.5},
+		{"median", summary.Median, 5.5},
+		{"p10", summary.P10, 1},
+		{"p90", summary.P90, 9},
+		{"min", summary.Min, 1},
+		{"max", summary.Max, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("Expected %s %.2f, got %.2f", tt.name, tt.expected, tt.got)
+			}
+		})
+	}
+
+	if len(summary.Histogram) != HistogramBuckets {
+		t.Fatalf("Expected %d buckets, got %d", HistogramBuckets, len(summary.Histogram))
+	}
+	count := 0
+	for _, bucket := range summary.Histogram {
+		count += bucket.Count
+	}
+	if count != len(values) {
+		t.Errorf("Histogram counts %d samples, expected %d", count, len(values))
+	}
+
+	if values[0] != 10 {
+		t.Errorf("Summarize must not reorder its input")
+	}
+}
+
+// TestSummarizeConstantSample tests a sample where every value is the same
+func TestSummarizeConstantSample(t *testing.T) {
+	summary := Summarize([]float64{3, 3, 3})
+	if len(summary.Histogram) != 1 || summary.Histogram[0].Count != 3 {
+		t.Errorf("Expected a single bucket holding every sample, got %+v", summary.Histogram)
+	}
+}
@@ -9,6 +9,10 @@ type DropItem struct {
 	Name        string
 	Probability float64 // Probability of this item dropping (0.0 to 1.0)
 	Price       int     // Price/value of the item
+	// MinQuantity and MaxQuantity give the uniform range of items per drop.
+	// Both zero means a single item.
+	MinQuantity int
+	MaxQuantity int
 }
 
 // DropTable is a collection of DropItems.
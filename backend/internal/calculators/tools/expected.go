@@ -0,0 +1,170 @@
+package tools
+
+import (
+	"math"
+)
+
+// ExpectedDrop is the exact mean and variance of the total quantity of one item
+type ExpectedDrop struct {
+	Name        string  `json:"name"`
+	Probability float64 `json:"probability"` // chance per roll
+	Quantity    float64 `json:"quantity"`
+	Variance    float64 `json:"variance"`
+	StdDev      float64 `json:"std_dev"`
+	Value       float64 `json:"value"`
+}
+
+// ExpectedLoot is the closed-form expectation of a number of rolls on one or more tables.
+// Unlike a simulation it is identical between requests for the same input.
+type ExpectedLoot struct {
+	Items         []ExpectedDrop `json:"items"`
+	TotalValue    float64        `json:"total_value"`
+	ValueVariance float64        `json:"value_variance"`
+	ValueStdDev   float64        `json:"value_std_dev"`
+}
+
+// quantityMoments returns the mean and second moment of the items given per drop.
+// Quantities are uniform between MinQuantity and MaxQuantity; zero values mean exactly one.
+func (item DropItem) quantityMoments() (mean, secondMoment float64) {
+	lo, hi := item.MinQuantity, item.MaxQuantity
+	if lo == 0 && hi == 0 {
+		return 1, 1
+	}
+	if hi < lo {
+		hi = lo
+	}
+
+	mean = float64(lo+hi) / 2
+	width := float64(hi - lo + 1)
+	variance := (width*width - 1) / 12
+	return mean, variance + mean*mean
+}
+
+// Expected returns the exact expected loot for a fixed number of rolls on an exclusive table,
+// where every roll gives at most one item (the model used by SimulateSingleDrop)
+func (dt DropTable) Expected(rolls int) ExpectedLoot {
+	return dt.ExpectedCompound(float64(rolls), 0)
+}
+
+// ExpectedCompound is Expected for a random number of rolls with the given mean and variance.
+// The variance of a random sum is E[N]·Var(X) + Var(N)·E[X]².
+func (dt DropTable) ExpectedCompound(meanRolls, rollVariance float64) ExpectedLoot {
+	items := make([]ExpectedDrop, 0, len(dt))
+	valuePerRoll, valueSquaredPerRoll := 0.0, 0.0
+
+	for _, item := range dt {
+		qMean, qSecond := item.quantityMoments()
+		price := float64(item.Price)
+
+		perRoll := item.Probability * qMean
+		perRollVariance := item.Probability*qSecond - perRoll*perRoll
+		items = append(items, newExpectedDrop(item, perRoll, perRollVariance, meanRolls, rollVariance))
+
+		// Items are mutually exclusive within a roll, so cross terms vanish
+		valuePerRoll += perRoll * price
+		valueSquaredPerRoll += item.Probability * qSecond * price * price
+	}
+
+	valueVariance := valueSquaredPerRoll - valuePerRoll*valuePerRoll
+	return newExpectedLoot(items, meanRolls*valuePerRoll, meanRolls*valueVariance+rollVariance*valuePerRoll*valuePerRoll)
+}
+
+// ExpectedIndependent returns the exact expected loot for a fixed number of rolls where every
+// item is checked independently on each roll, so one roll can give several items
+func (dt DropTable) ExpectedIndependent(rolls int) ExpectedLoot {
+	n := float64(rolls)
+	items := make([]ExpectedDrop, 0, len(dt))
+	totalValue, valueVariance := 0.0, 0.0
+
+	for _, item := range dt {
+		qMean, qSecond := item.quantityMoments()
+		price := float64(item.Price)
+
+		perRoll := item.Probability * qMean
+		perRollVariance := item.Probability*qSecond - perRoll*perRoll
+		drop := newExpectedDrop(item, perRoll, perRollVariance, n, 0)
+		items = append(items, drop)
+
+		totalValue += drop.Value
+		valueVariance += drop.Variance * price * price
+	}
+
+	return newExpectedLoot(items, totalValue, valueVariance)
+}
+
+// WithPrices returns a copy of the table with prices replaced by any matching live prices
+func (dt DropTable) WithPrices(livePrices map[string]int) DropTable {
+	priced := make(DropTable, len(dt))
+	copy(priced, dt)
+
+	for i, item := range priced {
+		if livePrice, exists := livePrices[item.Name]; exists {
+			priced[i].Price = livePrice
+		}
+	}
+
+	return priced
+}
+
+// MergeExpected combines the expected loot of independent sources.
+// Items with the same name are summed and keep the order in which they first appear.
+func MergeExpected(parts ...ExpectedLoot) ExpectedLoot {
+	var items []ExpectedDrop
+	index := make(map[string]int)
+	totalValue, valueVariance := 0.0, 0.0
+
+	for _, part := range parts {
+		for _, drop := range part.Items {
+			i, exists := index[drop.Name]
+			if !exists {
+				index[drop.Name] = len(items)
+				items = append(items, drop)
+				continue
+			}
+
+			merged := &items[i]
+			// The per-roll chance only makes sense for a single source
+			merged.Probability = 0
+			merged.Quantity += drop.Quantity
+			merged.Variance += drop.Variance
+			merged.StdDev = math.Sqrt(merged.Variance)
+			merged.Value += drop.Value
+		}
+
+		totalValue += part.TotalValue
+		valueVariance += part.ValueVariance
+	}
+
+	return newExpectedLoot(items, totalValue, valueVariance)
+}
+
+// newExpectedDrop scales the per-roll mean and variance of one item to a compound number of rolls
+func newExpectedDrop(item DropItem, perRoll, perRollVariance, meanRolls, rollVariance float64) ExpectedDrop {
+	quantity := meanRolls * perRoll
+	variance := meanRolls*perRollVariance + rollVariance*perRoll*perRoll
+
+	return ExpectedDrop{
+		Name:        item.Name,
+		Probability: item.Probability,
+		Quantity:    quantity,
+		Variance:    variance,
+		StdDev:      math.Sqrt(variance),
+		Value:       quantity * float64(item.Price),
+	}
+}
+
+// newExpectedLoot fills in the derived standard deviation
+func newExpectedLoot(items []ExpectedDrop, totalValue, valueVariance float64) ExpectedLoot {
+	if items == nil {
+		items = []ExpectedDrop{}
+	}
+	// Guard against tiny negative values from floating point cancellation
+	valueVariance = math.Max(valueVariance, 0)
+
+	return ExpectedLoot{
+		Items:         items,
+		TotalValue:    totalValue,
+		ValueVariance: valueVariance,
+		ValueStdDev:   math.Sqrt(valueVariance),
+	}
+}
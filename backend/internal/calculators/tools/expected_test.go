@@ -0,0 +1,137 @@
+package tools
+
+import (
+	"math"
+	"testing"
+)
+
+const expectedTolerance = 1e-9
+
+func assertClose(t *testing.T, name string, got, expected float64) {
+	t.Helper()
+	if math.Abs(got-expected) > expectedTolerance*math.Max(1, math.Abs(expected)) {
+		t.Errorf("Expected %s %.6f, got %.6f", name, expected, got)
+	}
+}
+
+// TestExpectedExclusiveTable checks the multinomial mean and variance for a fixed number of rolls
+func TestExpectedExclusiveTable(t *testing.T) {
+	table := DropTable{
+		{Name: "Common", Probability: 0.75, Price: 10},
+		{Name: "Rare", Probability: 0.25, Price: 100, MinQuantity: 2, MaxQuantity: 2},
+	}
+
+	loot := table.Expected(100)
+
+	if len(loot.Items) != 2 {
+		t.Fatalf("Expected 2 items, got %d", len(loot.Items))
+	}
+
+	// Common: Binomial(100, 0.75)
+	assertClose(t, "common quantity", loot.Items[0].Quantity, 75)
+	assertClose(t, "common variance", loot.Items[0].Variance, 100*0.75*0.25)
+	assertClose(t, "common value", loot.Items[0].Value, 750)
+
+	// Rare: 2 × Binomial(100, 0.25)
+	assertClose(t, "rare quantity", loot.Items[1].Quantity, 50)
+	assertClose(t, "rare variance", loot.Items[1].Variance, 4*100*0.25*0.75)
+	assertClose(t, "rare value", loot.Items[1].Value, 5000)
+
+	// Value per roll is 10 with p=0.75 or 200 with p=0.25
+	meanPerRoll := 0.75*10 + 0.25*200
+	variancePerRoll := 0.75*100 + 0.25*40000 - meanPerRoll*meanPerRoll
+	assertClose(t, "total value", loot.TotalValue, 100*meanPerRoll)
+	assertClose(t, "value variance", loot.ValueVariance, 100*variancePerRoll)
+	assertClose(t, "value std dev", loot.ValueStdDev, math.Sqrt(100*variancePerRoll))
+}
+
+// TestExpectedIndependentTable checks tables where every item is rolled separately
+func TestExpectedIndependentTable(t *testing.T) {
+	table := DropTable{
+		{Name: "A", Probability: 0.5, Price: 10},
+		{Name: "B", Probability: 0.1, Price: 1000},
+	}
+
+	loot := table.ExpectedIndependent(20)
+
+	assertClose(t, "A quantity", loot.Items[0].Quantity, 10)
+	assertClose(t, "B quantity", loot.Items[1].Quantity, 2)
+	assertClose(t, "total value", loot.TotalValue, 100+2000)
+	assertClose(t, "value variance", loot.ValueVariance, 20*0.25*100+20*0.09*1000000)
+}
+
+// TestExpectedQuantityRange checks uniform quantity ranges
+func TestExpectedQuantityRange(t *testing.T) {
+	table := DropTable{{Name: "Runes", Probability: 1, Price: 1, MinQuantity: 1, MaxQuantity: 6}}
+
+	loot := table.Expected(10)
+
+	// A fair die: mean 3.5, variance 35/12
+	assertClose(t, "quantity", loot.Items[0].Quantity, 35)
+	assertClose(t, "variance", loot.Items[0].Variance, 10*35.0/12.0)
+}
+
+// TestExpectedCompound checks the random-sum variance formula
+func TestExpectedCompound(t *testing.T) {
+	table := DropTable{{Name: "Coins", Probability: 0.5, Price: 1}}
+
+	loot := table.ExpectedCompound(10, 4)
+
+	// E[N]·p(1−p) + Var(N)·p²
+	assertClose(t, "quantity", loot.Items[0].Quantity, 5)
+	assertClose(t, "variance", loot.Items[0].Variance, 10*0.25+4*0.25)
+}
+
+// TestExpectedMatchesSimulation checks the closed form against the simulator's average
+func TestExpectedMatchesSimulation(t *testing.T) {
+	table := DropTable{
+		{Name: "Ranarr seed", Probability: 0.5, Price: 100},
+		{Name: "Snapdragon seed", Probability: 0.3, Price: 200},
+		{Name: "Torstol seed", Probability: 0.2, Price: 300},
+	}
+
+	const rolls = 200000
+	_, totalValue, err := SimulateMultipleDropsWithSeed(table, rolls, 7)
+	if err != nil {
+		t.Fatalf("Simulation failed: %v", err)
+	}
+
+	loot := table.Expected(rolls)
+	// Four standard deviations is far outside normal sampling noise
+	if math.Abs(float64(totalValue)-loot.TotalValue) > 4*loot.ValueStdDev {
+		t.Errorf("Simulated value %d is too far from expected %.0f (std dev %.0f)", totalValue, loot.TotalValue, loot.ValueStdDev)
+	}
+}
+
+// TestMergeExpected checks that merging sums matching items and keeps first-seen order
+func TestMergeExpected(t *testing.T) {
+	first := DropTable{{Name: "A", Probability: 1, Price: 1}}.Expected(3)
+	second := DropTable{
+		{Name: "B", Probability: 0.5, Price: 2},
+		{Name: "A", Probability: 0.5, Price: 1},
+	}.Expected(4)
+
+	merged := MergeExpected(first, second)
+
+	if len(merged.Items) != 2 || merged.Items[0].Name != "A" || merged.Items[1].Name != "B" {
+		t.Fatalf("Unexpected merged items: %+v", merged.Items)
+	}
+	assertClose(t, "A quantity", merged.Items[0].Quantity, 5)
+	assertClose(t, "A variance", merged.Items[0].Variance, 4*0.25)
+	assertClose(t, "total value", merged.TotalValue, first.TotalValue+second.TotalValue)
+	assertClose(t, "value variance", merged.ValueVariance, first.ValueVariance+second.ValueVariance)
+}
+
+// TestWithPrices checks live prices replace static ones without touching the original table
+func TestWithPrices(t *testing.T) {
+	table := DropTable{{Name: "A", Probability: 1, Price: 1}}
+
+	priced := table.WithPrices(map[string]int{"A": 50})
+
+	if priced[0].Price != 50 {
+		t.Errorf("Expected live price 50, got %d", priced[0].Price)
+	}
+	if table[0].Price != 1 {
+		t.Errorf("Original table was modified")
+	}
+}
@@ -0,0 +1,9 @@
+package tools
+
+// PriceOr returns the live price of item, or defaultValue when there is no live price for it
+func PriceOr(item string, defaultValue int, livePrices map[string]int) int {
+	if livePrice, exists := livePrices[item]; exists {
+		return livePrice
+	}
+	return defaultValue
+}
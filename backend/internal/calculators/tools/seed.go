@@ -0,0 +1,22 @@
+package tools
+
+import (
+	"math/rand"
+)
+
+// MaxSeed keeps generated seeds exactly representable as a JavaScript number,
+// so a seed echoed to the frontend can be sent back unchanged
+const MaxSeed = 1<<53 - 1
+
+// NewSeed returns a random seed for requests that did not provide one
+func NewSeed() int64 {
+	return rand.Int63n(MaxSeed)
+}
+
+// ResolveSeed returns the requested seed, or a new one when none was given
+func ResolveSeed(seed *int64) int64 {
+	if seed != nil {
+		return *seed
+	}
+	return NewSeed()
+}
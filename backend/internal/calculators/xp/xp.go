@@ -0,0 +1,101 @@
+// Package xp is the single source of truth for OSRS experience thresholds.
+// It covers real levels up to 99, virtual levels up to 126 and the 200M XP cap.
+package xp
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// MaxLevel is the highest real skill level
+	MaxLevel = 99
+	// MaxVirtualLevel is the highest virtual level shown by the game
+	MaxVirtualLevel = 126
+	// MaxXP is the most experience a skill can hold
+	MaxXP = 200_000_000
+)
+
+// table holds the total XP needed to reach each level, indexed by level.
+// Index 0 is unused so that table[1] == 0 and table[99] == 13,034,431.
+var table = buildTable()
+
+// buildTable generates the XP table from the game's formula:
+// XP(L) = floor(1/4 * sum_{n=1}^{L-1} floor(n + 300 * 2^(n/7)))
+func buildTable() [MaxVirtualLevel + 1]int {
+	var t [MaxVirtualLevel + 1]int
+	points := 0
+	for level := 2; level <= MaxVirtualLevel; level++ {
+		n := float64(level - 1)
+		points += int(math.Floor(n + 300*math.Pow(2, n/7)))
+		t[level] = points / 4
+	}
+	return t
+}
+
+// ForLevel returns the total XP required to reach level.
+// Levels below 1 return 0 and levels above MaxVirtualLevel are clamped.
+func ForLevel(level int) int {
+	if level < 1 {
+		return 0
+	}
+	if level > MaxVirtualLevel {
+		level = MaxVirtualLevel
+	}
+	return table[level]
+}
+
+// LevelForXP returns the real level (1-99) for a total XP amount
+func LevelForXP(xp int) int {
+	return min(VirtualLevelForXP(xp), MaxLevel)
+}
+
+// VirtualLevelForXP returns the virtual level (1-126) for a total XP amount
+func VirtualLevelForXP(xp int) int {
+	for level := MaxVirtualLevel; level > 1; level-- {
+		if xp >= table[level] {
+			return level
+		}
+	}
+	return 1
+}
+
+// Required returns the XP needed to go from currentLevel to targetLevel.
+// Both levels may be virtual levels up to MaxVirtualLevel.
+func Required(currentLevel, targetLevel int) (int, error) {
+	if currentLevel < 1 || targetLevel > MaxVirtualLevel || currentLevel >= targetLevel {
+		return 0, fmt.Errorf("invalid levels: current level must be < target level and both between 1 and %d", MaxVirtualLevel)
+	}
+	return table[targetLevel] - table[currentLevel], nil
+}
+
+// RequiredXP returns the XP needed to go from currentXP to targetXP.
+// Targets may go up to MaxXP.
+func RequiredXP(currentXP, targetXP int) (int, error) {
+	if err := ValidateXP(currentXP); err != nil {
+		return 0, err
+	}
+	if err := ValidateXP(targetXP); err != nil {
+		return 0, err
+	}
+	if targetXP <= currentXP {
+		return 0, fmt.Errorf("target XP (%d) must be greater than current XP (%d)", targetXP, currentXP)
+	}
+	return targetXP - currentXP, nil
+}
+
+// ValidateLevel checks that level is between 1 and MaxVirtualLevel
+func ValidateLevel(level int) error {
+	if level < 1 || level > MaxVirtualLevel {
+		return fmt.Errorf("level must be between 1 and %d, got %d", MaxVirtualLevel, level)
+	}
+	return nil
+}
+
+// ValidateXP checks that xp is between 0 and MaxXP
+func ValidateXP(xp int) error {
+	if xp < 0 || xp > MaxXP {
+		return fmt.Errorf("XP must be between 0 and %d, got %d", MaxXP, xp)
+	}
+	return nil
+}
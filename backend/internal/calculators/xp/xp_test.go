@@ -0,0 +1,267 @@
+package xp
+
+import (
+	"testing"
+)
+
+func TestLevelForXP(t *testing.T) {
+	tests := []struct {
+		name          string
+		xp            int
+		expectedLevel int
+	}{
+		{"Zero XP", 0, 1},
+		{"Just below level 2", 82, 1},
+		{"Exactly level 2", 83, 2},
+		{"Just above level 2", 84, 2},
+		{"Level 50", 101333, 50},
+		{"Level 70", 737627, 70},
+		{"Level 77", 1475581, 77},
+		{"Level 90", 5346332, 90},
+		{"Exactly level 99", 13034431, 99},
+		{"Above level 99", 20000000, 99}, // Should cap at 99
+		{"Max XP", MaxXP, 99},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := LevelForXP(tt.xp)
+			if result != tt.expectedLevel {
+				t.Errorf("LevelForXP(%d) = %d, want %d", tt.xp, result, tt.expectedLevel)
+			}
+		})
+	}
+}
+
+func TestVirtualLevelForXP(t *testing.T) {
+	tests := []struct {
+		name          string
+		xp            int
+		expectedLevel int
+	}{
+		{"Zero XP", 0, 1},
+		{"Exactly level 99", 13034431, 99},
+		{"Virtual level 100", 14391160, 100},
+		{"Just below level 100", 14391159, 99},
+		{"Virtual level 120", 104273167, 120},
+		{"Virtual level 126", 188884740, 126},
+		{"Max XP caps at 126", MaxXP, 126},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := VirtualLevelForXP(tt.xp)
+			if result != tt.expectedLevel {
+				t.Errorf("VirtualLevelForXP(%d) = %d, want %d", tt.xp, result, tt.expectedLevel)
+			}
+		})
+	}
+}
+
+func TestRequired(t *testing.T) {
+	tests := []struct {
+		name         string
+		currentLevel int
+		targetLevel  int
+		expectedXP   int
+		expectError  bool
+	}{
+		{
+			name:         "Level 1 to 2",
+			currentLevel: 1,
+			targetLevel:  2,
+			expectedXP:   83, // Level 2 requires 83 XP total, level 1 is 0, so 83-0=83
+		},
+		{
+			name:         "Level 50 to 70",
+			currentLevel: 50,
+			targetLevel:  70,
+			expectedXP:   737627 - 101333, // Level 70 XP - Level 50 XP
+		},
+		{
+			name:         "Level 77 to 99",
+			currentLevel: 77,
+			targetLevel:  99,
+			expectedXP:   13034431 - 1475581, // Level 99 XP - Level 77 XP
+		},
+		{
+			name:         "Level 98 to 99",
+			currentLevel: 98,
+			targetLevel:  99,
+			expectedXP:   13034431 - 11805606, // Should be exactly the XP difference
+		},
+		{
+			name:         "Level 99 to virtual 100",
+			currentLevel: 99,
+			targetLevel:  100,
+			expectedXP:   14391160 - 13034431,
+		},
+		{
+			name:         "Level 90 to virtual 126",
+			currentLevel: 90,
+			targetLevel:  126,
+			expectedXP:   188884740 - 5346332,
+		},
+		{
+			name:         "Invalid: current level 0",
+			currentLevel: 0,
+			targetLevel:  50,
+			expectError:  true,
+		},
+		{
+			name:         "Invalid: target level 127",
+			currentLevel: 50,
+			targetLevel:  127,
+			expectError:  true,
+		},
+		{
+			name:         "Invalid: current >= target",
+			currentLevel: 70,
+			targetLevel:  70,
+			expectError:  true,
+		},
+		{
+			name:         "Invalid: current > target",
+			currentLevel: 80,
+			targetLevel:  70,
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Required(tt.currentLevel, tt.targetLevel)
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+
+			if result != tt.expectedXP {
+				t.Errorf("Required(%d, %d) = %d, want %d",
+					tt.currentLevel, tt.targetLevel, result, tt.expectedXP)
+			}
+		})
+	}
+}
+
+func TestRequiredXP(t *testing.T) {
+	tests := []struct {
+		name        string
+		currentXP   int
+		targetXP    int
+		expectedXP  int
+		expectError bool
+	}{
+		{"99 to 200M", 13034431, MaxXP, MaxXP - 13034431, false},
+		{"Zero to level 2", 0, 83, 83, false},
+		{"Invalid: above 200M", 0, MaxXP + 1, 0, true},
+		{"Invalid: negative", -1, 1000, 0, true},
+		{"Invalid: target below current", 1000, 500, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := RequiredXP(tt.currentXP, tt.targetXP)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tt.expectedXP {
+				t.Errorf("RequiredXP(%d, %d) = %d, want %d", tt.currentXP, tt.targetXP, result, tt.expectedXP)
+			}
+		})
+	}
+}
+
+func TestTableConsistency(t *testing.T) {
+	// Test that the XP table is consistently increasing
+	for level := 2; level <= MaxVirtualLevel; level++ {
+		if ForLevel(level) <= ForLevel(level-1) {
+			t.Errorf("XP table should be increasing: level %d (%d) should be > level %d (%d)",
+				level, ForLevel(level), level-1, ForLevel(level-1))
+		}
+	}
+
+	// Test that level 1 starts at 0 XP
+	if ForLevel(1) != 0 {
+		t.Errorf("Level 1 should start at 0 XP, got %d", ForLevel(1))
+	}
+
+	// Test known XP values from the OSRS Wiki
+	knownValues := map[int]int{
+		2:   83,
+		10:  1154,
+		50:  101333,
+		92:  6517253,
+		99:  13034431,
+		100: 14391160,
+		110: 38737661,
+		126: 188884740,
+	}
+
+	for level, expectedXP := range knownValues {
+		if actualXP := ForLevel(level); actualXP != expectedXP {
+			t.Errorf("Level %d should require %d XP, got %d", level, expectedXP, actualXP)
+		}
+	}
+}
+
+// Test boundary conditions
+func TestBoundaryConditions(t *testing.T) {
+	if ForLevel(0) != 0 {
+		t.Errorf("Level 0 should clamp to 0 XP, got %d", ForLevel(0))
+	}
+	if ForLevel(200) != ForLevel(MaxVirtualLevel) {
+		t.Errorf("Levels above %d should clamp, got %d", MaxVirtualLevel, ForLevel(200))
+	}
+
+	for level := 2; level <= MaxVirtualLevel; level++ {
+		threshold := ForLevel(level)
+
+		if calculated := VirtualLevelForXP(threshold); calculated != level {
+			t.Errorf("Exact threshold test failed: VirtualLevelForXP(%d) = %d, want %d",
+				threshold, calculated, level)
+		}
+
+		if calculated := VirtualLevelForXP(threshold - 1); calculated != level-1 {
+			t.Errorf("Below threshold test failed: VirtualLevelForXP(%d) = %d, want %d",
+				threshold-1, calculated, level-1)
+		}
+	}
+}
+
+// Benchmark tests
+func BenchmarkLevelForXP(b *testing.B) {
+	xpValues := []int{100000, 500000, 1000000, 5000000, 10000000}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, xp := range xpValues {
+			LevelForXP(xp)
+		}
+	}
+}
+
+func BenchmarkRequired(b *testing.B) {
+	testCases := [][2]int{{1, 50}, {50, 77}, {77, 99}, {90, 126}}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, tc := range testCases {
+			Required(tc[0], tc[1])
+		}
+	}
+}
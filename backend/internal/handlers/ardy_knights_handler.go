@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	ardyknights "osrs-xp-kits/internal/calculators/technique/ardy_knights"
+	"osrs-xp-kits/internal/calculators/xp"
 )
 
 type ArdyKnightInput struct {
@@ -39,14 +40,14 @@ func ArdyKnightCalcHandler(w http.ResponseWriter, r *http.Request) {
 
 	if input.CurrentThievingXP != nil {
 		actualCurrentXP = *input.CurrentThievingXP
-		if actualCurrentXP < 0 {
-			http.Error(w, "Current Thieving XP cannot be negative.", http.StatusBadRequest)
+		if actualCurrentXP < 0 || actualCurrentXP > xp.MaxXP {
+			http.Error(w, fmt.Sprintf("Current Thieving XP must be between 0 and %d.", xp.MaxXP), http.StatusBadRequest)
 			return
 		}
 	} else if input.CurrentThievingLevel != nil {
 		lvl := *input.CurrentThievingLevel
-		if lvl < 1 || lvl > 99 {
-			http.Error(w, "Current Thieving Level must be between 1 and 99.", http.StatusBadRequest)
+		if lvl < 1 || lvl > xp.MaxVirtualLevel {
+			http.Error(w, fmt.Sprintf("Current Thieving Level must be between 1 and %d.", xp.MaxVirtualLevel), http.StatusBadRequest)
 			return
 		}
 		actualCurrentXP = ardyknights.GetTotalXPForLevel(lvl)
@@ -59,15 +60,15 @@ func ArdyKnightCalcHandler(w http.ResponseWriter, r *http.Request) {
 
 	if input.TargetThievingXP != nil {
 		actualTargetXP = *input.TargetThievingXP
-		if actualTargetXP < 0 {
-			http.Error(w, "Target Thieving XP cannot be negative.", http.StatusBadRequest)
+		if actualTargetXP < 0 || actualTargetXP > xp.MaxXP {
+			http.Error(w, fmt.Sprintf("Target Thieving XP must be between 0 and %d.", xp.MaxXP), http.StatusBadRequest)
 			return
 		}
 
 	} else if input.TargetThievingLevel != nil {
 		lvl := *input.TargetThievingLevel
-		if lvl < 1 || lvl > 99 { // Max OSRS level
-			http.Error(w, "Target Thieving Level must be between 1 and 99.", http.StatusBadRequest)
+		if lvl < 1 || lvl > xp.MaxVirtualLevel { // Max OSRS virtual level
+			http.Error(w, fmt.Sprintf("Target Thieving Level must be between 1 and %d.", xp.MaxVirtualLevel), http.StatusBadRequest)
 			return
 		}
 		actualTargetXP = ardyknights.GetTotalXPForLevel(lvl)
@@ -2,13 +2,18 @@ package handlers
 
 import (
 	"encoding/json"
+	"math"
 	"net/http"
+
 	"osrs-xp-kits/internal/calculators/technique/birdhouses"
+	"osrs-xp-kits/internal/calculators/tools"
 )
 
 type BirdhouseInput struct {
-	Type     string `json:"type"`
-	Quantity int    `json:"quantity"`
+	Type        string `json:"type"`
+	Quantity    int    `json:"quantity"`
+	Simulations int    `json:"simulations,omitempty"`
+	Seed        *int64 `json:"seed,omitempty"`
 }
 
 func BirdhouseCalcHandler(w http.ResponseWriter, r *http.Request) {
@@ -23,12 +28,27 @@ func BirdhouseCalcHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := birdhouses.CalculateBirdhouseData(input.Type, input.Quantity)
+	if err := tools.ValidateSimulations(input.Simulations); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	seed := tools.ResolveSeed(input.Seed)
+	result, err := birdhouses.CalculateBirdhouseDataWithSeed(input.Type, input.Quantity, nil, seed)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
+	if input.Simulations > 0 {
+		distribution, err := birdhouses.SimulateNestLootDistribution(r.Context(), int(math.Round(result.EstimatedNests)), nil, input.Simulations, seed)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		result.Distribution = &distribution
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(result)
 }
@@ -2,8 +2,11 @@ package handlers
 
 import (
 	"encoding/json"
+	"math"
 	"net/http"
+
 	"osrs-xp-kits/internal/calculators/technique/birdhouses"
+	"osrs-xp-kits/internal/calculators/tools"
 	"osrs-xp-kits/internal/services"
 )
 
@@ -24,6 +27,8 @@ type BirdhouseLiveInput struct {
 	Type          string `json:"type"`
 	Quantity      int    `json:"quantity"`
 	UseLivePrices bool   `json:"use_live_prices,omitempty"`
+	Simulations   int    `json:"simulations,omitempty"`
+	Seed          *int64 `json:"seed,omitempty"`
 }
 
 // BirdhouseLiveResponse extends the basic response with price information
@@ -56,6 +61,11 @@ func (h *BirdhouseLiveHandler) Calculate(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if err := tools.ValidateSimulations(input.Simulations); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	// Get live prices if requested
 	var livePrices map[string]int
 	var priceInfo *PriceInfo
@@ -85,16 +95,27 @@ func (h *BirdhouseLiveHandler) Calculate(w http.ResponseWriter, r *http.Request)
 	}
 
 	// Calculate birdhouse data
-	result, err := birdhouses.CalculateBirdhouseDataWithPrices(
+	seed := tools.ResolveSeed(input.Seed)
+	result, err := birdhouses.CalculateBirdhouseDataWithSeed(
 		input.Type,
 		input.Quantity,
 		livePrices,
+		seed,
 	)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
+	if input.Simulations > 0 {
+		distribution, err := birdhouses.SimulateNestLootDistribution(r.Context(), int(math.Round(result.EstimatedNests)), livePrices, input.Simulations, seed)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		result.Distribution = &distribution
+	}
+
 	// Create enhanced response
 	response := BirdhouseLiveResponse{
 		BirdhouseResult: result,
@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"osrs-xp-kits/internal/calculators"
+	"osrs-xp-kits/internal/calculators/tools"
+	"osrs-xp-kits/internal/services"
+	"osrs-xp-kits/pkg/response"
+)
+
+// CalculatorHandler serves every registered calculator through generic endpoints
+type CalculatorHandler struct {
+	registry     *calculators.Registry
+	cacheManager *services.CacheManager
+}
+
+// NewCalculatorHandler creates a handler backed by the given registry
+func NewCalculatorHandler(registry *calculators.Registry, cacheManager *services.CacheManager) *CalculatorHandler {
+	return &CalculatorHandler{
+		registry:     registry,
+		cacheManager: cacheManager,
+	}
+}
+
+// CalculatorRequestOptions are the request fields shared by every calculator.
+// They are read from the same JSON body as the calculator's own input.
+type CalculatorRequestOptions struct {
+	UseLivePrices bool   `json:"use_live_prices,omitempty"`
+	Simulations   int    `json:"simulations,omitempty"`
+	Seed          *int64 `json:"seed,omitempty"`
+}
+
+// CalculatorListResponse is returned by GET /api/calculators
+type CalculatorListResponse struct {
+	Calculators []calculators.Metadata `json:"calculators"`
+}
+
+// CalculatorResponse wraps a calculator result with the calculator's metadata
+type CalculatorResponse struct {
+	Calculator calculators.Metadata `json:"calculator"`
+	Result     any                  `json:"result"`
+	PriceInfo  *PriceInfo           `json:"price_info,omitempty"`
+	Seed       int64                `json:"seed"`
+}
+
+// List handles GET /api/calculators
+func (h *CalculatorHandler) List(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("only GET method allowed"))
+		return
+	}
+
+	response.Success(w, CalculatorListResponse{
+		Calculators: h.registry.List(),
+	})
+}
+
+// Handle serves /api/calculators/{id} and /api/calculators/{id}/tips.
+// GET returns metadata or tips, POST runs the calculation.
+func (h *CalculatorHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/calculators"), "/")
+	if path == "" {
+		h.List(w, r)
+		return
+	}
+
+	parts := strings.Split(path, "/")
+	entry, ok := h.registry.Get(parts[0])
+	if !ok {
+		response.Error(w, http.StatusNotFound, fmt.Errorf("calculator not found: %s", parts[0]))
+		return
+	}
+
+	switch {
+	case len(parts) == 1 && r.Method == http.MethodPost:
+		h.calculate(w, r, entry)
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		response.Success(w, entry.Metadata())
+	case len(parts) == 2 && parts[1] == "tips" && r.Method == http.MethodGet:
+		response.Success(w, entry.ProTips())
+	case len(parts) <= 2:
+		response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+	default:
+		response.Error(w, http.StatusNotFound, fmt.Errorf("unknown path: %s", r.URL.Path))
+	}
+}
+
+// calculate decodes the shared options, resolves prices and runs the calculator
+func (h *CalculatorHandler) calculate(w http.ResponseWriter, r *http.Request, entry calculators.Entry) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, fmt.Errorf("reading request body: %w", err))
+		return
+	}
+
+	var reqOpts CalculatorRequestOptions
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &reqOpts); err != nil {
+			response.Error(w, http.StatusBadRequest, fmt.Errorf("invalid JSON: %w", err))
+			return
+		}
+	}
+
+	if err := tools.ValidateSimulations(reqOpts.Simulations); err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	prices, priceInfo, err := resolvePrices(h.cacheManager, reqOpts.UseLivePrices)
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	seed := tools.ResolveSeed(reqOpts.Seed)
+	result, err := entry.Calculate(body, calculators.Options{
+		Prices:      prices,
+		Simulations: reqOpts.Simulations,
+		Seed:        seed,
+		Context:     r.Context(),
+	})
+	if err != nil {
+		if !errors.Is(err, calculators.ErrInvalidInput) {
+			err = fmt.Errorf("calculation error: %w", err)
+		}
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	response.Success(w, CalculatorResponse{
+		Calculator: entry.Metadata(),
+		Result:     result,
+		PriceInfo:  priceInfo,
+		Seed:       seed,
+	})
+}
+
+// resolvePrices returns live prices from the cache manager when requested,
+// together with the PriceInfo describing where the prices came from
+func resolvePrices(cacheManager *services.CacheManager, useLivePrices bool) (map[string]int, *PriceInfo, error) {
+	if !useLivePrices {
+		return nil, &PriceInfo{Source: "static"}, nil
+	}
+
+	prices, err := cacheManager.GetPrices()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch live prices: %w", err)
+	}
+
+	priceInfo := &PriceInfo{
+		Source:     "live",
+		PricesUsed: prices,
+	}
+
+	cacheStatus := cacheManager.GetCacheStatus()
+	if lastUpdated, ok := cacheStatus["last_updated"].(string); ok {
+		priceInfo.LastUpdated = lastUpdated
+	}
+
+	return prices, priceInfo, nil
+}
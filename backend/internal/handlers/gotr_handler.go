@@ -3,13 +3,17 @@ package handlers
 import (
 	"encoding/json"
 	"net/http"
+
 	"osrs-xp-kits/internal/calculators/technique/gotr"
+	"osrs-xp-kits/internal/calculators/tools"
 )
 
 // GOTRInput represents the input structure for GOTR calculations
 type GOTRInput struct {
-	CurrentLevel int `json:"current_level"`
-	TargetLevel  int `json:"target_level"`
+	CurrentLevel int    `json:"current_level"`
+	TargetLevel  int    `json:"target_level"`
+	Simulations  int    `json:"simulations,omitempty"`
+	Seed         *int64 `json:"seed,omitempty"`
 }
 
 // GOTRCalcHandler handles HTTP requests for GOTR calculations
@@ -41,12 +45,27 @@ func GOTRCalcHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := tools.ValidateSimulations(input.Simulations); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	// Calculate GOTR data
 	result, err := gotr.CalculateGOTRData(input.CurrentLevel, input.TargetLevel)
 	if err != nil {
 		http.Error(w, "Calculation error: "+err.Error(), http.StatusBadRequest)
 		return
 	}
+	result.Seed = tools.ResolveSeed(input.Seed)
+
+	if input.Simulations > 0 {
+		distribution, err := gotr.SimulateRewardsDistribution(r.Context(), result.TotalRewardRolls, input.Simulations, result.Seed)
+		if err != nil {
+			http.Error(w, "Calculation error: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		result.Distribution = &distribution
+	}
 
 	// Set response headers
 	w.Header().Set("Content-Type", "application/json")
@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"osrs-xp-kits/internal/calculators"
+	"osrs-xp-kits/internal/calculators/probability"
+	"osrs-xp-kits/internal/calculators/tools"
+	"osrs-xp-kits/pkg/response"
+)
+
+// ProbabilityHandler answers drop-rate questions, either for a single rate or for
+// the uniques of a registered calculator
+type ProbabilityHandler struct {
+	registry *calculators.Registry
+}
+
+// NewProbabilityHandler creates a handler that can look up calculators in the given registry
+func NewProbabilityHandler(registry *calculators.Registry) *ProbabilityHandler {
+	return &ProbabilityHandler{registry: registry}
+}
+
+// ProbabilityRequest is the body of POST /api/probability.
+// Either a rate (or one_in) with attempts, or a calculator ID with its input, must be given.
+type ProbabilityRequest struct {
+	Name        string          `json:"name,omitempty"`
+	Rate        float64         `json:"rate,omitempty"`
+	OneIn       float64         `json:"one_in,omitempty"`
+	Attempts    int             `json:"attempts,omitempty"`
+	K           int             `json:"k,omitempty"`
+	Confidences []float64       `json:"confidences,omitempty"`
+	Calculator  string          `json:"calculator,omitempty"`
+	Input       json.RawMessage `json:"input,omitempty"`
+}
+
+// ProbabilityResponse holds the odds for every requested drop
+type ProbabilityResponse struct {
+	Calculator *calculators.Metadata  `json:"calculator,omitempty"`
+	Odds       []probability.DropOdds `json:"odds"`
+}
+
+// Calculate handles POST /api/probability
+func (h *ProbabilityHandler) Calculate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, fmt.Errorf("only POST method allowed"))
+		return
+	}
+
+	var req ProbabilityRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, fmt.Errorf("invalid JSON: %w", err))
+		return
+	}
+
+	if req.Calculator != "" {
+		h.calculatorOdds(w, req)
+		return
+	}
+
+	rate := req.Rate
+	if req.OneIn != 0 {
+		if rate != 0 {
+			response.Error(w, http.StatusBadRequest, fmt.Errorf("provide either rate or one_in, not both"))
+			return
+		}
+		if req.OneIn < 1 {
+			response.Error(w, http.StatusBadRequest, fmt.Errorf("one_in must be at least 1"))
+			return
+		}
+		rate = 1 / req.OneIn
+	}
+
+	k := req.K
+	if k == 0 {
+		k = 1
+	}
+
+	odds, err := probability.NewDropOdds(req.Name, rate, req.Attempts, k, req.Confidences...)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	response.Success(w, ProbabilityResponse{
+		Odds: []probability.DropOdds{odds},
+	})
+}
+
+// calculatorOdds runs a registered calculator with static prices and returns the odds of its uniques
+func (h *ProbabilityHandler) calculatorOdds(w http.ResponseWriter, req ProbabilityRequest) {
+	entry, ok := h.registry.Get(req.Calculator)
+	if !ok {
+		response.Error(w, http.StatusNotFound, fmt.Errorf("calculator not found: %s", req.Calculator))
+		return
+	}
+
+	result, err := entry.Calculate(req.Input, calculators.Options{Seed: tools.NewSeed()})
+	if err != nil {
+		if !errors.Is(err, calculators.ErrInvalidInput) {
+			err = fmt.Errorf("calculation error: %w", err)
+		}
+		response.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	reporter, ok := result.(calculators.UniqueOddsReporter)
+	if !ok {
+		response.Error(w, http.StatusBadRequest, fmt.Errorf("calculator %s does not track unique drops", req.Calculator))
+		return
+	}
+
+	metadata := entry.Metadata()
+	response.Success(w, ProbabilityResponse{
+		Calculator: &metadata,
+		Odds:       reporter.UniqueDropOdds(),
+	})
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"osrs-xp-kits/internal/calculators/technique/sepulchre"
+	"osrs-xp-kits/internal/services"
+)
+
+// SepulchreHandler handles Hallowed Sepulchre calculations with optional live loot prices
+type SepulchreHandler struct {
+	cacheManager *services.CacheManager
+}
+
+// NewSepulchreHandler creates a new Hallowed Sepulchre handler with live price support
+func NewSepulchreHandler(cacheManager *services.CacheManager) *SepulchreHandler {
+	return &SepulchreHandler{
+		cacheManager: cacheManager,
+	}
+}
+
+type SepulchreInput struct {
+	AgilityLevel  int     `json:"agility_level"`
+	HighestFloor  int     `json:"highest_floor,omitempty"`
+	Hours         float64 `json:"hours,omitempty"`
+	UseLivePrices bool    `json:"use_live_prices,omitempty"`
+}
+
+// SepulchreResponse extends the result with price information
+type SepulchreResponse struct {
+	sepulchre.SepulchreResult
+	PriceInfo *PriceInfo `json:"price_info,omitempty"`
+}
+
+// Calculate handles POST /api/tools/sepulchre
+func (h *SepulchreHandler) Calculate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Only POST allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var input SepulchreInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	livePrices, priceInfo, err := resolvePrices(h.cacheManager, input.UseLivePrices)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	result, err := sepulchre.CalculateSepulchreDataWithPrices(
+		input.AgilityLevel,
+		input.HighestFloor,
+		input.Hours,
+		livePrices,
+	)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(SepulchreResponse{
+		SepulchreResult: result,
+		PriceInfo:       priceInfo,
+	})
+}
+
+// SepulchreProTipsHandler provides detailed calculation methodology and tips
+func SepulchreProTipsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Only GET method allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	tips := sepulchre.GetCalculationProTips()
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(tips)
+}
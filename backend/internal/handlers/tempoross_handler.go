@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"osrs-xp-kits/internal/calculators/technique/tempoross"
+	"osrs-xp-kits/internal/calculators/tools"
+	"osrs-xp-kits/internal/services"
+)
+
+// TemporossHandler handles Tempoross calculations with optional live prices
+type TemporossHandler struct {
+	cacheManager *services.CacheManager
+}
+
+// NewTemporossHandler creates a new Tempoross handler with live price support
+func NewTemporossHandler(cacheManager *services.CacheManager) *TemporossHandler {
+	return &TemporossHandler{
+		cacheManager: cacheManager,
+	}
+}
+
+type TemporossInput struct {
+	CurrentLevel         int      `json:"current_level"`
+	TargetLevel          int      `json:"target_level"`
+	FishType             string   `json:"fish_type"`
+	CustomPointsPerGame  *int     `json:"custom_points_per_game,omitempty"`
+	CustomMinutesPerGame *float64 `json:"custom_minutes_per_game,omitempty"`
+	UseLivePrices        bool     `json:"use_live_prices,omitempty"`
+	Simulations          int      `json:"simulations,omitempty"`
+	Seed                 *int64   `json:"seed,omitempty"`
+}
+
+// TemporossResponse extends the result with price information
+type TemporossResponse struct {
+	tempoross.TemporossResult
+	PriceInfo *PriceInfo `json:"price_info,omitempty"`
+}
+
+// Calculate handles POST /api/tempoross
+func (h *TemporossHandler) Calculate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Only POST allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var input TemporossInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	if err := tools.ValidateSimulations(input.Simulations); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	livePrices, priceInfo, err := resolvePrices(h.cacheManager, input.UseLivePrices)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	seed := tools.ResolveSeed(input.Seed)
+	result, err := tempoross.CalculateTemporossDataWithSeed(
+		input.CurrentLevel,
+		input.TargetLevel,
+		tempoross.FishType(input.FishType),
+		input.CustomPointsPerGame,
+		input.CustomMinutesPerGame,
+		livePrices,
+		seed,
+	)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if input.Simulations > 0 {
+		distribution, err := tempoross.SimulateRewardPoolDistribution(r.Context(), result.TotalPermits, livePrices, input.Simulations, seed)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		result.Distribution = &distribution
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(TemporossResponse{
+		TemporossResult: result,
+		PriceInfo:       priceInfo,
+	})
+}
+
+// TemporossProTipsHandler provides detailed calculation methodology and tips
+func TemporossProTipsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Only GET method allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	tips := tempoross.GetCalculationProTips()
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(tips)
+}
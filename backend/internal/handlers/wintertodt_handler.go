@@ -3,7 +3,9 @@ package handlers
 import (
 	"encoding/json"
 	"net/http"
+
 	"osrs-xp-kits/internal/calculators/technique/wintertodt"
+	"osrs-xp-kits/internal/calculators/tools"
 )
 
 type WintertodtInput struct {
@@ -14,6 +16,8 @@ type WintertodtInput struct {
 	CustomMinutesPerRound *float64               `json:"custom_minutes_per_round,omitempty"`
 	SkillLevels           wintertodt.SkillLevels `json:"skill_levels"`
 	UseLivePrices         bool                   `json:"use_live_prices,omitempty"`
+	Simulations           int                    `json:"simulations,omitempty"`
+	Seed                  *int64                 `json:"seed,omitempty"`
 }
 
 func WintertodtCalcHandler(w http.ResponseWriter, r *http.Request) {
@@ -28,22 +32,39 @@ func WintertodtCalcHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := tools.ValidateSimulations(input.Simulations); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	// Convert strategy string to Strategy type
 	strategy := wintertodt.Strategy(input.Strategy)
 
-	result, err := wintertodt.CalculateWintertodtData(
+	seed := tools.ResolveSeed(input.Seed)
+	result, err := wintertodt.CalculateWintertodtDataWithSeed(
 		input.CurrentLevel,
 		input.TargetLevel,
 		strategy,
 		input.CustomPointsPerRound,
 		input.CustomMinutesPerRound,
 		input.SkillLevels,
+		nil,
+		seed,
 	)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
+	if input.Simulations > 0 {
+		distribution, err := wintertodt.SimulateLootDistribution(r.Context(), result.RoundsNeeded, result.PointsPerRound, input.SkillLevels, nil, input.Simulations, seed)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		result.Distribution = &distribution
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(result)
 }
@@ -3,7 +3,9 @@ package handlers
 import (
 	"encoding/json"
 	"net/http"
+
 	"osrs-xp-kits/internal/calculators/technique/wintertodt"
+	"osrs-xp-kits/internal/calculators/tools"
 	"osrs-xp-kits/internal/services"
 )
 
@@ -29,6 +31,8 @@ type WintertodtLiveInput struct {
 	SkillLevels           wintertodt.SkillLevels `json:"skill_levels"`
 	UseLivePrices         bool                   `json:"use_live_prices,omitempty"`
 	Username              string                 `json:"username,omitempty"` // Optional: auto-populate skill levels
+	Simulations           int                    `json:"simulations,omitempty"`
+	Seed                  *int64                 `json:"seed,omitempty"`
 }
 
 // WintertodtLiveResponse extends the basic response with price information
@@ -68,6 +72,11 @@ func (h *WintertodtLiveHandler) Calculate(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	if err := tools.ValidateSimulations(input.Simulations); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	// Auto-populate skill levels if username is provided
 	skillLevels := input.SkillLevels
 	if input.Username != "" {
@@ -114,7 +123,8 @@ func (h *WintertodtLiveHandler) Calculate(w http.ResponseWriter, r *http.Request
 	}
 
 	// Calculate Wintertodt data
-	result, err := wintertodt.CalculateWintertodtDataWithPrices(
+	seed := tools.ResolveSeed(input.Seed)
+	result, err := wintertodt.CalculateWintertodtDataWithSeed(
 		input.CurrentLevel,
 		input.TargetLevel,
 		strategy,
@@ -122,12 +132,22 @@ func (h *WintertodtLiveHandler) Calculate(w http.ResponseWriter, r *http.Request
 		input.CustomMinutesPerRound,
 		skillLevels,
 		livePrices,
+		seed,
 	)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
+	if input.Simulations > 0 {
+		distribution, err := wintertodt.SimulateLootDistribution(r.Context(), result.RoundsNeeded, result.PointsPerRound, skillLevels, livePrices, input.Simulations, seed)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		result.Distribution = &distribution
+	}
+
 	// Create enhanced response
 	response := WintertodtLiveResponse{
 		WintertodtResult: result,
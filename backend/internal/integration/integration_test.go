@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -201,6 +202,46 @@ func TestWintertodtEndpoint(t *testing.T) {
 	}
 }
 
+// TestTemporossEndpoint tests the Tempoross calculator endpoint
+func TestTemporossEndpoint(t *testing.T) {
+	payload := map[string]interface{}{
+		"current_level": 50,
+		"target_level":  70,
+		"fish_type":     "cooked",
+		"seed":          42,
+	}
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Failed to marshal payload: %v", err)
+	}
+
+	resp, err := http.Post(
+		testServer.URL+"/api/tempoross",
+		"application/json",
+		bytes.NewBuffer(jsonPayload),
+	)
+	if err != nil {
+		t.Fatalf("Failed to make request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	var result map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	for _, field := range []string{"games_needed", "total_permits", "pet_chance", "estimated_loot", "price_info"} {
+		if _, exists := result[field]; !exists {
+			t.Errorf("Response missing required field: %s", field)
+		}
+	}
+}
+
 // TestArdyKnightsEndpoint tests the Ardy Knights calculator endpoint
 func TestArdyKnightsEndpoint(t *testing.T) {
 	payload := map[string]interface{}{
@@ -279,6 +320,275 @@ func TestBirdhouseEndpoint(t *testing.T) {
 	}
 }
 
+// TestCalculatorsListEndpoint tests that every technique calculator is registered
+func TestCalculatorsListEndpoint(t *testing.T) {
+	resp, err := http.Get(testServer.URL + "/api/calculators")
+	if err != nil {
+		t.Fatalf("Failed to make request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Calculators []struct {
+			ID string `json:"id"`
+		} `json:"calculators"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	registered := make(map[string]bool)
+	for _, calc := range result.Calculators {
+		registered[calc.ID] = true
+	}
+
+	for _, id := range []string{"ardy_knights", "birdhouses", "blast_furnace", "chinchompas", "fishing_methods", "giants_foundry", "gotr", "herbiboar", "herblore", "herb_runs", "mahogany_homes", "motherlode", "prayer", "pyramid_plunder", "rooftops", "runecrafting", "sepulchre", "slayer", "tempoross", "tree_runs", "volcanic_mine", "wintertodt", "zalcano"} {
+		if !registered[id] {
+			t.Errorf("Calculator %s is not registered", id)
+		}
+	}
+}
+
+// TestGenericCalculatorEndpoint tests running calculators through /api/calculators/{id}
+func TestGenericCalculatorEndpoint(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		payload        map[string]interface{}
+		expectedStatus int
+	}{
+		{
+			name:           "GOTR",
+			id:             "gotr",
+			payload:        map[string]interface{}{"current_level": 77, "target_level": 99},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Wintertodt",
+			id:             "wintertodt",
+			payload:        map[string]interface{}{"current_level": 50, "target_level": 99, "strategy": "solo"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Ardy knights by level",
+			id:             "ardy_knights",
+			payload:        map[string]interface{}{"current_thieving_level": 60, "target_thieving_level": 80},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid input",
+			id:             "gotr",
+			payload:        map[string]interface{}{"current_level": 20, "target_level": 99},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Unknown calculator",
+			id:             "does_not_exist",
+			payload:        map[string]interface{}{},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonPayload, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Fatalf("Failed to marshal payload: %v", err)
+			}
+
+			resp, err := http.Post(
+				testServer.URL+"/api/calculators/"+tt.id,
+				"application/json",
+				bytes.NewBuffer(jsonPayload),
+			)
+			if err != nil {
+				t.Fatalf("Failed to make request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.expectedStatus {
+				t.Fatalf("Expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+
+			if resp.StatusCode == http.StatusOK {
+				var result map[string]interface{}
+				if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+					t.Fatalf("Failed to decode response: %v", err)
+				}
+				for _, field := range []string{"calculator", "result", "price_info", "seed"} {
+					if _, exists := result[field]; !exists {
+						t.Errorf("Response missing required field: %s", field)
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestCalculatorSeedReproducible tests that the same seed reproduces the exact same response
+func TestCalculatorSeedReproducible(t *testing.T) {
+	payloads := map[string]map[string]interface{}{
+		"wintertodt": {"current_level": 50, "target_level": 99, "strategy": "solo", "seed": 42, "simulations": 20},
+		"birdhouses": {"type": "redwood", "quantity": 400, "seed": 42, "simulations": 20},
+	}
+
+	for id, payload := range payloads {
+		t.Run(id, func(t *testing.T) {
+			jsonPayload, err := json.Marshal(payload)
+			if err != nil {
+				t.Fatalf("Failed to marshal payload: %v", err)
+			}
+
+			var bodies [2]string
+			for i := range bodies {
+				resp, err := http.Post(
+					testServer.URL+"/api/calculators/"+id,
+					"application/json",
+					bytes.NewBuffer(jsonPayload),
+				)
+				if err != nil {
+					t.Fatalf("Failed to make request: %v", err)
+				}
+				body, err := io.ReadAll(resp.Body)
+				resp.Body.Close()
+				if err != nil {
+					t.Fatalf("Failed to read response: %v", err)
+				}
+				if resp.StatusCode != http.StatusOK {
+					t.Fatalf("Expected status 200, got %d: %s", resp.StatusCode, body)
+				}
+				bodies[i] = string(body)
+			}
+
+			if bodies[0] != bodies[1] {
+				t.Errorf("Same seed produced different responses")
+			}
+
+			var result map[string]interface{}
+			if err := json.Unmarshal([]byte(bodies[0]), &result); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if seed, _ := result["seed"].(float64); seed != 42 {
+				t.Errorf("Expected seed 42 to be echoed, got %v", result["seed"])
+			}
+		})
+	}
+}
+
+// TestGOTRSeedEchoed tests that a generated seed is returned so the distribution can be reproduced
+func TestGOTRSeedEchoed(t *testing.T) {
+	payload := map[string]interface{}{
+		"current_level": 50,
+		"target_level":  60,
+		"simulations":   5,
+	}
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Failed to marshal payload: %v", err)
+	}
+
+	resp, err := http.Post(
+		testServer.URL+"/api/tools/gotr",
+		"application/json",
+		bytes.NewBuffer(jsonPayload),
+	)
+	if err != nil {
+		t.Fatalf("Failed to make request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Seed         int64 `json:"seed"`
+		Distribution struct {
+			Seed int64 `json:"seed"`
+		} `json:"distribution"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	if result.Seed == 0 || result.Seed != result.Distribution.Seed {
+		t.Errorf("Expected the generated seed to be echoed, got %d and distribution seed %d", result.Seed, result.Distribution.Seed)
+	}
+}
+
+// TestProbabilityEndpoint tests drop odds for a single rate and for a calculator's uniques
+func TestProbabilityEndpoint(t *testing.T) {
+	tests := []struct {
+		name           string
+		payload        map[string]interface{}
+		expectedStatus int
+		expectedOdds   int
+	}{
+		{
+			name:           "Single rate",
+			payload:        map[string]interface{}{"one_in": 5000, "attempts": 5000},
+			expectedStatus: http.StatusOK,
+			expectedOdds:   1,
+		},
+		{
+			name: "Calculator uniques",
+			payload: map[string]interface{}{
+				"calculator": "wintertodt",
+				"input":      map[string]interface{}{"current_level": 50, "target_level": 99, "strategy": "solo"},
+			},
+			expectedStatus: http.StatusOK,
+			expectedOdds:   3,
+		},
+		{
+			name:           "Calculator without uniques",
+			payload:        map[string]interface{}{"calculator": "ardy_knights", "input": map[string]interface{}{"current_thieving_level": 55, "target_thieving_level": 60}},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Missing rate",
+			payload:        map[string]interface{}{"attempts": 100},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonPayload, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Fatalf("Failed to marshal payload: %v", err)
+			}
+
+			resp, err := http.Post(testServer.URL+"/api/probability", "application/json", bytes.NewBuffer(jsonPayload))
+			if err != nil {
+				t.Fatalf("Failed to make request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.expectedStatus {
+				body, _ := io.ReadAll(resp.Body)
+				t.Fatalf("Expected status %d, got %d: %s", tt.expectedStatus, resp.StatusCode, body)
+			}
+
+			if tt.expectedStatus == http.StatusOK {
+				var result struct {
+					Odds []map[string]interface{} `json:"odds"`
+				}
+				if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+					t.Fatalf("Failed to decode response: %v", err)
+				}
+				if len(result.Odds) != tt.expectedOdds {
+					t.Errorf("Expected %d odds, got %d", tt.expectedOdds, len(result.Odds))
+				}
+			}
+		})
+	}
+}
+
 // TestCORSHeaders tests that CORS headers are properly set
 func TestCORSHeaders(t *testing.T) {
 	req, err := http.NewRequest("OPTIONS", testServer.URL+"/api/tools/gotr", nil)
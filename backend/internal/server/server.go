@@ -5,6 +5,7 @@ import (
 	"slices"
 	"strings"
 
+	"osrs-xp-kits/internal/calculators/technique"
 	"osrs-xp-kits/internal/config"
 	"osrs-xp-kits/internal/domain/skill"
 	"osrs-xp-kits/internal/handlers"
@@ -65,6 +66,13 @@ func (s *Server) setupRoutes() {
 	wintertodtLiveHandler := handlers.NewWintertodtLiveHandler(s.cacheManager)
 	birdhouseLiveHandler := handlers.NewBirdhouseLiveHandler(s.cacheManager)
 	herbiboarLiveHandler := handlers.NewHerbiboarLiveHandler(s.cacheManager)
+	temporossHandler := handlers.NewTemporossHandler(s.cacheManager)
+	sepulchreHandler := handlers.NewSepulchreHandler(s.cacheManager)
+
+	// Create the generic handler serving every registered technique calculator
+	registry := technique.NewRegistry()
+	calculatorHandler := handlers.NewCalculatorHandler(registry, s.cacheManager)
+	probabilityHandler := handlers.NewProbabilityHandler(registry)
 
 	// Health check endpoint
 	s.mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
@@ -81,16 +89,25 @@ func (s *Server) setupRoutes() {
 	s.mux.HandleFunc("/api/ardyknights", handlers.ArdyKnightCalcHandler)
 	s.mux.HandleFunc("/api/wintertodt", handlers.WintertodtCalcHandler)
 	s.mux.HandleFunc("/api/wintertodt/live", wintertodtLiveHandler.Calculate)
+	s.mux.HandleFunc("/api/tempoross", temporossHandler.Calculate)
 	s.mux.HandleFunc("/api/tools/gotr", handlers.GOTRCalcHandler)
 	s.mux.HandleFunc("/api/tools/gotr/strategy", handlers.GOTRStrategyHandler)
 	s.mux.HandleFunc("/api/tools/gotr/tips", handlers.GOTRProTipsHandler)
+	s.mux.HandleFunc("/api/tools/sepulchre", sepulchreHandler.Calculate)
+	s.mux.HandleFunc("/api/tools/sepulchre/tips", handlers.SepulchreProTipsHandler)
 
 	// Tips endpoints for other calculators
 	s.mux.HandleFunc("/api/tools/wintertodt/tips", handlers.WintertodtProTipsHandler)
+	s.mux.HandleFunc("/api/tools/tempoross/tips", handlers.TemporossProTipsHandler)
 	s.mux.HandleFunc("/api/tools/ardyknights/tips", handlers.ArdyKnightProTipsHandler)
 	s.mux.HandleFunc("/api/tools/birdhouse/tips", handlers.BirdhouseProTipsHandler)
 	s.mux.HandleFunc("/api/tools/herbiboar/tips", handlers.HerbiboarProTipsHandler)
 
+	// Generic calculator endpoints backed by the technique registry
+	s.mux.HandleFunc("/api/calculators", calculatorHandler.List)
+	s.mux.HandleFunc("/api/calculators/", calculatorHandler.Handle)
+	s.mux.HandleFunc("/api/probability", probabilityHandler.Calculate)
+
 	// New skill data handler
 	s.mux.HandleFunc("/api/skill-data/", handlers.NewSkillHandler(skillService))
 
@@ -26,7 +26,9 @@ type CacheData struct {
 }
 
 const (
-	CacheVersion     = "1.0"
+	// CacheVersion must be bumped whenever itemIDMap grows, so caches written
+	// before the new items were tracked are refetched instead of used
+	CacheVersion     = "1.1"
 	PriceCacheFile   = "price_cache.json"
 	CacheRefreshHour = 6 // Refresh at 6 AM daily
 )
@@ -101,17 +101,236 @@ var itemIDMap = map[string]int{
 	"Burnt page":        20718,
 	"Magic seeds":       5316,
 	"Torstol seeds":     5304,
+	// Tempoross reward pool items
+	"Tome of water":   25576,
+	"Spirit flakes":   25588,
+	"Raw harpoonfish": 25564,
+	"Raw tuna":        359,
+	"Raw swordfish":   371,
+	"Feather":         314,
+	"Plank":           960,
+	// Motherlode Mine ores
+	"Coal":           453,
+	"Gold ore":       444,
+	"Mithril ore":    447,
+	"Adamantite ore": 449,
+	"Runite ore":     451,
+	// Hallowed Sepulchre coffin loot
+	"Death rune":        560,
+	"Blood rune":        565,
+	"Law rune":          563,
+	"Nature rune":       561,
+	"Adamant arrow":     890,
+	"Rune arrow":        892,
+	"Shark":             385,
+	"Ring of endurance": 24844,
+	// Rooftop agility mark of grace rewards
+	"Amylase crystal": 12640,
+	// Herb run seeds and compost
+	"Guam seed":        5291,
+	"Marrentill seed":  5292,
+	"Tarromin seed":    5293,
+	"Harralander seed": 5294,
+	"Ranarr seed":      5295,
+	"Toadflax seed":    5296,
+	"Irit seed":        5297,
+	"Avantoe seed":     5298,
+	"Kwuarm seed":      5299,
+	"Snapdragon seed":  5300,
+	"Cadantine seed":   5301,
+	"Lantadyme seed":   5302,
+	"Dwarf weed seed":  5303,
+	"Torstol seed":     5304,
+	"Compost":          6032,
+	"Supercompost":     6034,
+	"Ultracompost":     21483,
+	// Tree run saplings and protection payments
+	"Oak sapling":         5370,
+	"Willow sapling":      5371,
+	"Maple sapling":       5372,
+	"Yew sapling":         5373,
+	"Magic sapling":       5374,
+	"Spirit sapling":      5375,
+	"Apple sapling":       5496,
+	"Banana sapling":      5497,
+	"Orange sapling":      5498,
+	"Curry sapling":       5499,
+	"Pineapple sapling":   5500,
+	"Papaya sapling":      5501,
+	"Palm sapling":        5502,
+	"Calquat sapling":     5503,
+	"Teak sapling":        21477,
+	"Mahogany sapling":    21480,
+	"Dragonfruit sapling": 22866,
+	"Celastrus sapling":   22856,
+	"Redwood sapling":     22859,
+	"Tomatoes(5)":         5968,
+	"Apples(5)":           5386,
+	"Oranges(5)":          5396,
+	"Strawberries(5)":     5406,
+	"Bananas(5)":          5416,
+	"Cactus spine":        6016,
+	"Coconut":             5974,
+	"Sweetcorn":           5986,
+	"Watermelon":          5982,
+	"Pineapple":           2114,
+	"Papaya fruit":        5972,
+	"Poison ivy berries":  6018,
+	"Potato cactus":       3138,
+	"Dragonfruit":         22929,
+	"Monkey nuts":         4012,
+	"Limpwurt root":       225,
+	"Yanillian hops":      5994,
+	// Prayer bones, ashes and secondaries
+	"Bones":                 526,
+	"Big bones":             532,
+	"Babydragon bones":      534,
+	"Wyrm bones":            22780,
+	"Dragon bones":          536,
+	"Wyvern bones":          6812,
+	"Drake bones":           22783,
+	"Lava dragon bones":     11943,
+	"Hydra bones":           22786,
+	"Dagannoth bones":       6729,
+	"Superior dragon bones": 22124,
+	"Fiendish ashes":        25766,
+	"Vile ashes":            25769,
+	"Malicious ashes":       25772,
+	"Abyssal ashes":         25775,
+	"Infernal ashes":        25778,
+	"Pot":                   1931,
+	"Bucket of slime":       4286,
+	"Soul rune":             566,
+	"Wrath rune":            21880,
+	// Herblore ingredients and potions
+	"Vial of water":            227,
+	"Amulet of chemistry":      21163,
+	"Guam leaf":                249,
+	"Marrentill":               251,
+	"Tarromin":                 253,
+	"Harralander":              255,
+	"Ranarr weed":              257,
+	"Irit leaf":                259,
+	"Avantoe":                  261,
+	"Kwuarm":                   263,
+	"Cadantine":                265,
+	"Dwarf weed":               267,
+	"Lantadyme":                2481,
+	"Toadflax":                 2998,
+	"Snapdragon":               3000,
+	"Guam potion (unf)":        91,
+	"Marrentill potion (unf)":  93,
+	"Tarromin potion (unf)":    95,
+	"Harralander potion (unf)": 97,
+	"Ranarr potion (unf)":      99,
+	"Irit potion (unf)":        101,
+	"Avantoe potion (unf)":     103,
+	"Kwuarm potion (unf)":      105,
+	"Cadantine potion (unf)":   107,
+	"Dwarf weed potion (unf)":  109,
+	"Lantadyme potion (unf)":   2483,
+	"Toadflax potion (unf)":    3002,
+	"Snapdragon potion (unf)":  3004,
+	"Eye of newt":              221,
+	"Unicorn horn dust":        235,
+	"Chocolate dust":           1975,
+	"Snape grass":              231,
+	"Mort myre fungus":         2970,
+	"Red spiders' eggs":        223,
+	"White berries":            239,
+	"Dragon scale dust":        241,
+	"Wine of zamorak":          245,
+	"Crushed nest":             6693,
+	"Attack potion(4)":         2428,
+	"Attack potion(3)":         121,
+	"Antipoison(4)":            2446,
+	"Antipoison(3)":            175,
+	"Strength potion(4)":       113,
+	"Strength potion(3)":       115,
+	"Energy potion(4)":         3008,
+	"Energy potion(3)":         3010,
+	"Prayer potion(4)":         2434,
+	"Prayer potion(3)":         139,
+	"Super attack(4)":          2436,
+	"Super attack(3)":          145,
+	"Superantipoison(4)":       2448,
+	"Superantipoison(3)":       181,
+	"Super energy(4)":          3016,
+	"Super energy(3)":          3018,
+	"Super strength(4)":        2440,
+	"Super strength(3)":        157,
+	"Super restore(4)":         3024,
+	"Super restore(3)":         3026,
+	"Super defence(4)":         2442,
+	"Super defence(3)":         163,
+	"Antifire potion(4)":       2452,
+	"Antifire potion(3)":       2454,
+	"Ranging potion(4)":        2444,
+	"Ranging potion(3)":        169,
+	"Magic potion(4)":          3040,
+	"Magic potion(3)":          3042,
+	"Saradomin brew(4)":        6685,
+	"Saradomin brew(3)":        6687,
+	// Runecraft runes and supplies
+	"Air rune":          556,
+	"Mind rune":         558,
+	"Water rune":        555,
+	"Earth rune":        557,
+	"Fire rune":         554,
+	"Body rune":         559,
+	"Cosmic rune":       564,
+	"Chaos rune":        562,
+	"Astral rune":       9075,
+	"Lava rune":         4699,
+	"Binding necklace":  5521,
+	"Stamina potion(4)": 12625,
+	// Smithing bars and Blast Furnace ores
+	"Bronze bar":     2349,
+	"Iron bar":       2351,
+	"Steel bar":      2353,
+	"Mithril bar":    2359,
+	"Adamantite bar": 2361,
+	"Runite bar":     2363,
+	"Iron ore":       440,
+	"Silver ore":     442,
+	"Silver bar":     2355,
+	"Gold bar":       2357,
+	// Pyramid Plunder artefacts
+	"Ivory comb":        9026,
+	"Golden scarab":     9028,
+	"Stone scarab":      9030,
+	"Pottery scarab":    9032,
+	"Golden statuette":  9034,
+	"Pottery statuette": 9036,
+	"Stone statuette":   9038,
+	"Gold seal":         9040,
+	"Stone seal":        9042,
+	// Construction planks
+	"Oak plank":      8778,
+	"Teak plank":     8780,
+	"Mahogany plank": 8782,
+	// Chinchompas
+	"Chinchompa":       10033,
+	"Red chinchompa":   10034,
+	"Black chinchompa": 11959,
+	// Aerial fishing
+	"Molch pearl": 22820,
+	// Zalcano and Volcanic Mine rewards
+	"Uncut ruby":        1619,
+	"Uncut dragonstone": 1631,
+	"Numulite":          21555,
+	"Volcanic ash":      21622,
 	// Herbiboar herbs
 	"Grimy guam leaf":   199,
 	"Grimy marrentill":  201,
 	"Grimy tarromin":    203,
 	"Grimy harralander": 205,
-	"Grimy toadflax":    2998,
+	"Grimy toadflax":    3049,
 	"Grimy irit leaf":   209,
 	"Grimy avantoe":     211,
 	"Grimy kwuarm":      213,
 	"Grimy cadantine":   215,
-	"Grimy lantadyme":   2481,
+	"Grimy lantadyme":   2485,
 	"Grimy dwarf weed":  217,
 	// Birdhouse nest loot items
 	"Acorn":                 5312,
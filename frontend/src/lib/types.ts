@@ -13,6 +13,7 @@ export interface ArdyKnightResult {
     target_total_xp: number;
     xp_to_target: number;
     hours_to_target: number;
+    attempts_to_target: number;
     pickpockets_to_target: number;
 }
 